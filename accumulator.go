@@ -0,0 +1,153 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+// An Accumulator sums real numbers exactly. The running total grows its
+// significand as needed so that no rounding occurs until the result is
+// requested, at which point it is rounded once to the accumulator's precision
+// and rounding mode. As a result, totals are reproducible regardless of the
+// order values are added in.
+//
+// A zero value for an Accumulator is an empty sum with the default precision
+// and rounding mode.
+type Accumulator struct {
+	sum       *Real // exact running total
+	precision uint  // precision of the result
+	mode      int   // rounding mode of the result
+}
+
+// Set the precision of the result. The running total is unaffected.
+func (a *Accumulator) SetPrecision(p uint) {
+	a.precision = p
+}
+
+// Returns the precision of the result.
+func (a *Accumulator) Precision() uint {
+	if a.precision == 0 {
+		return DefaultPrecision
+	}
+	return a.precision
+}
+
+// Set the rounding mode of the result.
+func (a *Accumulator) SetMode(m int) error {
+	r := new(Real)
+	err := r.SetMode(m)
+	if err != nil {
+		return err
+	}
+	a.mode = m
+	return nil
+}
+
+// Returns the rounding mode of the result.
+func (a *Accumulator) Mode() int {
+	return a.mode
+}
+
+// Reset the running total to zero. Precision and rounding mode are unchanged.
+func (a *Accumulator) Reset() {
+	a.sum = nil
+}
+
+// Add x to the running total.
+func (a *Accumulator) Add(x *Real) {
+	if a.sum == nil {
+		a.sum = new(Real)
+	}
+
+	if x.form != FormReal || a.sum.form != FormReal {
+		a.sum = a.sum.Add(x)
+		return
+	} else if x.IsZero() {
+		return
+	}
+
+	// Widen the total so the sum is exact. The digits of the result can
+	// span from the larger leading digit (plus one for carry) down to the
+	// smaller trailing digit.
+	hi := x.exponent
+	lo := x.exponent - x.ndigits() + 1
+	if !a.sum.IsZero() {
+		if a.sum.exponent > hi {
+			hi = a.sum.exponent
+		}
+		if l := a.sum.exponent - a.sum.ndigits() + 1; l < lo {
+			lo = l
+		}
+	}
+	a.sum.precision = uint(hi-lo) + 2
+	a.sum = a.sum.Add(x)
+}
+
+// Subtract x from the running total.
+func (a *Accumulator) Sub(x *Real) {
+	xn := x.Copy()
+	xn.negative = !xn.negative
+	if xn.IsZero() {
+		xn.negative = false
+	}
+	a.Add(xn)
+}
+
+// Add the exact product x*y to the running total.
+func (a *Accumulator) AddProduct(x, y *Real) {
+	p := uint(x.ndigits()+y.ndigits()) + 1
+	x2 := x.Copy()
+	x2.precision = p
+	y2 := y.Copy()
+	y2.precision = p
+	a.Add(x2.mul(y2))
+}
+
+// Returns the running total rounded to the accumulator's precision and
+// rounding mode.
+func (a *Accumulator) Result() *Real {
+	z := &Real{
+		precision: a.Precision(),
+		mode:      a.mode,
+	}
+	if a.sum == nil {
+		return z
+	}
+	z.CopyValue(a.sum)
+	return z
+}
+
+// Return the exact sum of xs, rounded once. The result has the largest
+// precision of xs and the rounding mode of the first element.
+func Sum(xs []*Real) *Real {
+	var a Accumulator
+	for i, x := range xs {
+		x.validate()
+		if i == 0 {
+			a.mode = x.mode
+		}
+		a.precision = umax(a.precision, x.precision)
+		a.Add(x)
+	}
+	return a.Result()
+}
+
+// Return the exact dot product of xs and ys, rounded once. The result has the
+// largest precision of xs and ys and the rounding mode of the first element of
+// xs. Dot panics if the lengths of xs and ys differ.
+func Dot(xs, ys []*Real) *Real {
+	if len(xs) != len(ys) {
+		panic("mismatched lengths")
+	}
+
+	var a Accumulator
+	for i := range xs {
+		xs[i].validate()
+		ys[i].validate()
+		if i == 0 {
+			a.mode = xs[i].mode
+		}
+		a.precision = umax(a.precision, umax(xs[i].precision, ys[i].precision))
+		a.AddProduct(xs[i], ys[i])
+	}
+	return a.Result()
+}
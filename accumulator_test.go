@@ -0,0 +1,103 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import "testing"
+
+func TestAccumulator(t *testing.T) {
+	big, _ := ParseReal("1e40", DefaultPrecision)
+	nbig, _ := ParseReal("-1e40", DefaultPrecision)
+	one := NewInt64(1)
+
+	// rounding at each step loses the 1
+	z := big.Add(one).Add(nbig)
+	if z.String() != "0" {
+		t.Fatal("invalid add", z)
+	}
+
+	var a Accumulator
+	a.Add(big)
+	a.Add(one)
+	a.Add(nbig)
+	z = a.Result()
+	if z.String() != "1e0" {
+		t.Fatal("invalid sum", z)
+	}
+}
+
+func TestAccumulatorOrder(t *testing.T) {
+	var xs []*Real
+	for i := 1; i <= 200; i++ {
+		x := NewInt64(1)
+		x = x.Div(NewInt64(int64(i)))
+		x.exponent += (i % 7) * 5
+		xs = append(xs, x)
+	}
+
+	var a, b Accumulator
+	for i := range xs {
+		a.Add(xs[i])
+		b.Add(xs[len(xs)-1-i])
+	}
+	if a.Result().Compare(b.Result()) != 0 {
+		t.Fatal("order dependent sum", a.Result(), b.Result())
+	}
+}
+
+func TestAccumulatorSub(t *testing.T) {
+	var a Accumulator
+	a.SetPrecision(5)
+	a.Add(NewInt64(123456))
+	a.Sub(NewInt64(7))
+	z := a.Result()
+	if z.String() != "1.2345e5" || z.Precision() != 5 {
+		t.Fatal("invalid sub", z)
+	}
+
+	a.Reset()
+	if a.Result().String() != "0" {
+		t.Fatal("invalid reset")
+	}
+}
+
+func TestAccumulatorInf(t *testing.T) {
+	inf := new(Real)
+	inf.form = FormInf
+
+	var a Accumulator
+	a.Add(NewInt64(1))
+	a.Add(inf)
+	if a.Result().String() != "∞" {
+		t.Fatal("invalid sum", a.Result())
+	}
+	a.Sub(inf)
+	if a.Result().String() != "NaN" {
+		t.Fatal("invalid sum", a.Result())
+	}
+}
+
+func TestSum(t *testing.T) {
+	x, _ := ParseReal("0.1", DefaultPrecision)
+	xs := make([]*Real, 10)
+	for i := range xs {
+		xs[i] = x
+	}
+
+	z := Sum(xs)
+	if z.String() != "1e0" {
+		t.Fatal("invalid sum", z)
+	}
+}
+
+func TestDot(t *testing.T) {
+	x, _ := ParseReal("1.000000000000000000000000000000001", DefaultPrecision)
+	y, _ := ParseReal("-1", DefaultPrecision)
+
+	// x*x == 1.000000000000000000000000000000002000000000000000000000000000000001
+	z := Dot([]*Real{x, y}, []*Real{x, x})
+	if z.String() != "1.000000000000000000000000000000001e-33" {
+		t.Fatal("invalid dot", z)
+	}
+}
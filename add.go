@@ -4,10 +4,6 @@
 
 package number
 
-import (
-	"bytes"
-)
-
 // Return the sum of x and y.
 func (x *Real) Add(y *Real) *Real {
 	x.validate()
@@ -86,121 +82,16 @@ func (z *Real) add(x, y *Real) {
 		}
 	}
 
-	var addend []byte
-
-	// Shift the larger exponent value to the left, padding zeros to make way for the sum. For example:
-	//
-	//	xxxxxxxxxx
-	//	    yyyyyyyyyy
-	//
-	// would become
-	//	xxxxxxxxxx0000
-	//	    yyyyyyyyyy
-	//
-	// Keep in mind that the number of digits in either value may be less
-	// than the precision, so you could end up with something like:
-	//
-	//	xxxxxxxxxx
-	//	   yyy
-	//
-	// In which case you don't need to pad at all.
-	switch {
-	case shiftAmount < 0:
-		// y's exponent is larger
-		z.CopyValue(y)
-		addend = x.significand
-	case shiftAmount == 0:
-		// same exponent, just pad
-		z.CopyValue(x)
-		addend = y.significand
-	case shiftAmount > 0:
-		// x's exponent is larger
-		z.CopyValue(x)
-		addend = y.significand
-	}
-
-	// If the addend's significand extends beyond z's, we must pad.
-	if sa+len(addend) > len(z.significand) {
-		s := make([]byte, sa+len(addend))
-		copy(s, z.significand)
-
-		// now we can just copy the addend's tail up to z and chop off
-		// the tail from the addend, giving us:
-		//
-		// 	xxxxxyyy
-		//	   yy
-		//
-		// This saves us the trouble of doing useless adds on zeros.
-		//
-		// It's also possible that z has trailing zeros, in which case
-		// we can opportunistically move up as any overlap there as
-		// well:
-		//
-		//	x0yyyyy
-
-		digits := len(s) - len(z.significand) // Maximum number of digits we can move up, from the right
-		// abs(shiftAmount) is the starting position of the addend
-		if len(addend) < digits {
-			// truncate down to the number of digits in the addend if it's shorter
-			digits = len(addend)
-		}
-		copy(s[len(s)-digits:], addend[len(addend)-digits:])
-		addend = addend[:len(addend)-digits]
-
-		z.significand = s
-	}
-
-	// Sum from right to left, overflowing if we need. The significands may
-	// not be the same length, so we determine the starting point and sum
-	// from there.
-	//
-	// The addends can be in two shapes:
-	//
-	// 	xxxxxxxxxx
-	//	yyyyyyyyyy
-	//
-	//	xxxxxZZ
-	//	  yyy
-	i := int(umin(uint(len(z.significand)), uint(len(addend)))) - 1
-
-	for ; i >= 0; i-- {
-		z.significand[i+sa] += addend[i]
-		if z.significand[i+sa] > 9 {
-			z.significand[i+sa] -= 10
-			if i+sa == 0 {
-				// overflow
-				z.exponent++
-				s := make([]byte, len(z.significand)+1)
-				s[0] = 1
-				copy(s[1:], z.significand)
-				z.significand = s
-			} else {
-				z.significand[i+sa-1]++
-			}
-		}
-	}
-
-	// Continue checking for carry from i on z
-	for i = i + sa; i >= 0 && z.significand[i] > 9; i-- {
-		z.significand[i] -= 10
-		if i == 0 {
-			// overflow
-			z.exponent++
-			s := make([]byte, len(z.significand)+1)
-			s[0] = 1
-			copy(s[1:], z.significand)
-			z.significand = s
-		} else {
-			z.significand[i-1]++
-		}
-	}
+	xs, ys, lo := align(x, y)
+	z.significand = addLimbs(xs, ys)
+	z.exponent = lo + z.ndigits() - 1
 }
 
 // Subtract the significands of x and y into the significand of z, ignoring the
 // sign.
 func (z *Real) sub(x, y *Real) {
 	// check for aliasing
-	if (y.exponent > x.exponent) || (x.exponent == y.exponent && bytes.Compare(x.significand, y.significand) == -1) {
+	if (y.exponent > x.exponent) || (x.exponent == y.exponent && cmpSignificands(x.significand, y.significand) == -1) {
 		z.negative = !z.negative
 		z.sub(y, x)
 		return
@@ -223,75 +114,28 @@ func (z *Real) sub(x, y *Real) {
 		}
 	}
 
-	var addend []byte
-
-	// Shift the larger exponent value to the left, padding zeros to make way for the sum. For example:
-	//
-	//	xxxxxxxxxx
-	//	    yyyyyyyyyy
-	//
-	// would become
-	//	xxxxxxxxxx0000
-	//	    yyyyyyyyyy
-	//
-	// Keep in mind that the number of digits in either value may be less
-	// than the precision, so you could end up with something like:
-	//
-	//	xxxxxxxxxx
-	//	   yyy
-	//
-	// In which case you don't need to pad at all.
-	switch {
-	case shiftAmount < 0:
-		// y's exponent is larger
-		z.CopyValue(y)
-		addend = make([]byte, len(x.significand))
-		copy(addend, x.significand)
-	case shiftAmount == 0:
-		// same exponent, just pad
-		z.CopyValue(x)
-		addend = make([]byte, len(y.significand))
-		copy(addend, y.significand)
-	case shiftAmount > 0:
-		// x's exponent is larger
-		z.CopyValue(x)
-		addend = make([]byte, len(y.significand))
-		copy(addend, y.significand)
-	}
-
-	// If the addend's significand extends beyond z's, we must pad.
-	if sa+len(addend) > len(z.significand) {
-		s := make([]byte, sa+len(addend))
-		copy(s, z.significand)
-		z.significand = s
-	}
-
-	i := int(umin(uint(len(z.significand)), uint(len(addend)))) - 1
-
-	var carry bool
-	for ; i >= 0; i-- {
-		if z.significand[i+sa] >= addend[i] {
-			z.significand[i+sa] -= addend[i]
-		} else {
-			z.significand[i+sa] += 10 - addend[i]
-			if i == 0 {
-				carry = true
-			} else {
-				addend[i-1]++
-			}
-		}
-	}
+	xs, ys, lo := align(x, y)
+	z.significand = subLimbs(xs, ys)
+	z.exponent = lo + z.ndigits() - 1
+}
 
-	if carry {
-		for i = i + sa; i >= 0; i-- {
-			if z.significand[i] == 0 {
-				z.significand[i] = 9
-			} else {
-				z.significand[i]--
-				break
-			}
-		}
-	}
+// Return the significands of x and y as integers scaled to the same trailing
+// digit, along with the exponent of that digit. The significand with the
+// higher trailing digit is shifted left, padding zeros to make way for the
+// sum. For example:
+//
+//	xxxxxxxxxx
+//	    yyyyyyyyyy
+//
+// would become
+//
+//	xxxxxxxxxx0000
+//	    yyyyyyyyyy
+func align(x, y *Real) (xs, ys limbs, lo int) {
+	lx := x.exponent - x.ndigits() + 1
+	ly := y.exponent - y.ndigits() + 1
+	lo = min(lx, ly)
+	return x.significand.shl(lx - lo), y.significand.shl(ly - lo), lo
 }
 
 // Return the subtraction of y from x.
@@ -302,3 +146,55 @@ func (x *Real) Sub(y *Real) *Real {
 	yn.negative = !yn.negative
 	return x.Add(yn)
 }
+
+// Return the sum of x and the int64 y. The sum is exact and rounded once, so
+// the result is correctly rounded to the precision and rounding mode of x.
+// Unlike x.Add(NewInt64(y)), no temporary Real is allocated for y.
+func (x *Real) AddInt64(y int64) *Real {
+	x.validate()
+	var buf [3]uint32
+	yr := int64Operand(x, y, &buf)
+	return x.addExactly(&yr)
+}
+
+// Return the subtraction of the int64 y from x. The difference is exact and
+// rounded once to the precision and rounding mode of x.
+func (x *Real) SubInt64(y int64) *Real {
+	x.validate()
+	var buf [3]uint32
+	yr := int64Operand(x, y, &buf)
+	if !yr.IsZero() {
+		yr.negative = !yr.negative
+	}
+	return x.addExactly(&yr)
+}
+
+// Return x + y, rounded once to the precision and rounding mode of x.
+func (x *Real) addExactly(y *Real) *Real {
+	a := Accumulator{precision: x.precision, mode: x.mode}
+	a.Add(x.sticky(y, x.precision))
+	a.Add(y.sticky(x, x.precision))
+	return a.Result()
+}
+
+// Return y, or when y is below the digits of x and far below the position at
+// which x + y rounds to precision p, a single digit of the same sign in its
+// place. The sum of x and either lies between the same two neighboring
+// rounding points, so it rounds the same way, and the exact sum stays no wider
+// than x and p.
+func (y *Real) sticky(x *Real, p uint) *Real {
+	if x.form != FormReal || y.form != FormReal || x.IsZero() || y.IsZero() {
+		return y
+	}
+	// two digits below the last digit of x and the rounding position,
+	// which may drop by one when y cancels the leading digit
+	t := min(x.exponent-x.ndigits()+1, x.exponent-int(p)) - 3
+	if y.exponent > t {
+		return y
+	}
+	z := initFrom(y)
+	z.SetInt64(1)
+	z.exponent = t
+	z.negative = y.negative
+	return z
+}
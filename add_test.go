@@ -131,8 +131,8 @@ func TestSub3(t *testing.T) {
 func BenchmarkAdd(b *testing.B) {
 	x := new(Real)
 	y := new(Real)
-	x.significand = []byte{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9}
-	y.significand = []byte{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9}
+	x.setSignificandDigits([]byte{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9})
+	y.setSignificandDigits([]byte{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9})
 	x.validate()
 	y.validate()
 	for b.Loop() {
@@ -166,3 +166,73 @@ func TestAddRoundEven2(t *testing.T) {
 		t.Fatal("invalid add", z)
 	}
 }
+
+func TestAddInt64(t *testing.T) {
+	x, _ := ParseReal("1.5", DefaultPrecision)
+
+	z := x.AddInt64(-3)
+	if z.String() != "-1.5e0" {
+		t.Fatal("invalid add", z)
+	}
+
+	z = x.SubInt64(-9223372036854775808)
+	if z.String() != "9.2233720368547758095e18" {
+		t.Fatal("invalid sub", z)
+	}
+
+	x.SetPrecision(5)
+	z = x.AddInt64(123456)
+	if z.String() != "1.2346e5" || z.Precision() != 5 {
+		t.Fatal("invalid add", z)
+	}
+}
+
+func TestAddInt64RoundsOnce(t *testing.T) {
+	// 1234450000000000000.0000000001 is just above the tie, so it rounds up,
+	// while rounding the sum to 20 digits first would make it a tie that
+	// rounds to even.
+	x, _ := ParseReal("1e-10", 5)
+
+	z := x.AddInt64(1234450000000000000)
+	if z.String() != "1.2345e18" || z.Precision() != 5 {
+		t.Fatal("invalid add", z)
+	}
+
+	z = x.SubInt64(-1234450000000000000)
+	if z.String() != "1.2345e18" {
+		t.Fatal("invalid sub", z)
+	}
+
+	x.SetMode(ModeZero)
+	z = x.SubInt64(1234450000000000000)
+	if z.String() != "-1.2344e18" {
+		t.Fatal("invalid sub", z)
+	}
+}
+
+// A tiny x only decides the direction of rounding, so the sum must not be
+// as wide as the gap between x and y.
+func TestAddInt64LargeGap(t *testing.T) {
+	x, _ := ParseReal("1e-1000000000", DefaultPrecision)
+	if z := x.AddInt64(1); z.String() != "1e0" {
+		t.Fatal("invalid add", z)
+	}
+	if z := x.SubInt64(1); z.String() != "-1e0" {
+		t.Fatal("invalid sub", z)
+	}
+
+	x.SetMode(ModeZero)
+	if z := x.SubInt64(1); z.String() != "-9.999999999999999999999999999999999e-1" {
+		t.Fatal("invalid sub", z)
+	}
+	x.negative = true
+	if z := x.AddInt64(1000); z.String() != "9.999999999999999999999999999999999e2" {
+		t.Fatal("invalid add", z)
+	}
+
+	x, _ = ParseReal("1e1000000000", 5)
+	x.SetMode(ModeZero)
+	if z := x.SubInt64(1); z.String() != "9.9999e999999999" {
+		t.Fatal("invalid sub", z)
+	}
+}
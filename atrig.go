@@ -0,0 +1,191 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import "fmt"
+
+// Return the arctangent of x, in radians. The result is correctly rounded.
+func (x *Real) Atan() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		return x.working(w).atan(), int(w) - internalPrecisionBuffer
+	})
+}
+
+func (x *Real) atan() *Real {
+	if x.IsNaN() {
+		z := initFrom(x)
+		z.form = FormNaN
+		return z
+	} else if x.IsInf() {
+		// atan(±∞) == ±π/2
+		z := pi(x).div(NewUint64(2))
+		z.negative = x.negative
+		return z
+	} else if x.IsZero() {
+		return initFrom(x)
+	}
+
+	one := initFrom(x)
+	one.SetUint64(1)
+
+	// atan(x) == π/2 - atan(1/x) for x > 1
+	xa := x.Abs()
+	if xa.Compare(one) == 1 {
+		z := pi(x).div(NewUint64(2)).Sub(xa.reciprocal().atan())
+		z.negative = x.negative
+		return z
+	}
+
+	// Halve the angle with atan(x) == 2*atan(x/(1+sqrt(1+x²))) until the
+	// Taylor series converges quickly.
+	var halvings int
+	for xa.exponent >= -1 {
+		xa = xa.div(one.Add(one.Add(xa.mul(xa)).sqrt()))
+		halvings++
+	}
+
+	// atan(x) == x - x³/3 + x⁵/5 - ...
+	z := xa.Copy()
+	x2 := xa.mul(xa)
+	x2.negative = true
+	p := xa.Copy()
+	var converged bool
+	for i := 1; i < MaxTrigIterations; i++ {
+		p = p.mul(x2)
+		d := initFrom(x)
+		d.SetUint64(uint64(2*i + 1))
+		zn := z.Add(p.div(d))
+		if z.Compare(zn) == 0 {
+			converged = true
+			break
+		}
+		z = zn
+	}
+	if !converged {
+		panic(fmt.Sprintf("failed to converge atan(%v)", x))
+	}
+
+	if halvings != 0 {
+		m := initFrom(x)
+		m.SetUint64(1 << halvings)
+		z = z.mul(m)
+	}
+	z.negative = x.negative
+	return z
+}
+
+// Return the arcsine of x, in radians. The result is correctly rounded. The
+// result is NaN if |x| > 1.
+func (x *Real) Asin() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		return x.working(w).asin(), int(w) - internalPrecisionBuffer
+	})
+}
+
+func (x *Real) asin() *Real {
+	one := initFrom(x)
+	one.SetUint64(1)
+
+	if x.IsNaN() || x.IsInf() || x.Abs().Compare(one) == 1 {
+		z := initFrom(x)
+		z.form = FormNaN
+		return z
+	} else if x.IsZero() {
+		return initFrom(x)
+	} else if x.Abs().Compare(one) == 0 {
+		z := pi(x).div(NewUint64(2))
+		z.negative = x.negative
+		return z
+	}
+
+	// asin(x) == atan(x/sqrt(1-x²)), where 1-x² == (1-x)(1+x) avoids
+	// cancellation near |x| == 1.
+	d := one.Sub(x).mul(one.Add(x)).sqrt()
+	return x.div(d).atan()
+}
+
+// Return the arccosine of x, in radians. The result is correctly rounded. The
+// result is NaN if |x| > 1.
+func (x *Real) Acos() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		return x.working(w).acos(), int(w) - internalPrecisionBuffer
+	})
+}
+
+func (x *Real) acos() *Real {
+	one := initFrom(x)
+	one.SetUint64(1)
+
+	if x.IsNaN() || x.IsInf() || x.Abs().Compare(one) == 1 {
+		z := initFrom(x)
+		z.form = FormNaN
+		return z
+	} else if x.Compare(one) == 0 {
+		return initFrom(x)
+	} else if x.Add(one).IsZero() {
+		return pi(x)
+	}
+
+	// acos(x) == 2*atan(sqrt((1-x)/(1+x))), which unlike π/2 - asin(x)
+	// doesn't cancel near x == 1.
+	z := one.Sub(x).div(one.Add(x)).sqrt().atan()
+	return z.mul(NewUint64(2))
+}
+
+// Return the angle, in radians, of the point (x, y) from the positive x axis,
+// where y is the receiver. The result is in [-π, π] and is correctly rounded.
+// Special cases follow the conventions of math.Atan2. Real has no negative
+// zero, so a zero y is always treated as +0.
+func (y *Real) Atan2(x *Real) *Real {
+	x.validate()
+	return y.correctlyRounded(func(w uint) (*Real, int) {
+		return y.working(w).atan2(x.working(umax(w, x.precision))), int(w) - internalPrecisionBuffer - 1
+	})
+}
+
+func (y *Real) atan2(x *Real) *Real {
+	z := initFrom(y)
+
+	if x.IsNaN() || y.IsNaN() {
+		z.form = FormNaN
+		return z
+	}
+
+	p := pi(y)
+	halfPi := p.div(NewUint64(2))
+
+	switch {
+	case y.IsInf() && x.IsInf():
+		// ±π/4 or ±3π/4
+		z = p.div(NewUint64(4))
+		if x.negative {
+			z = z.mul(NewUint64(3))
+		}
+	case y.IsInf():
+		z = halfPi
+	case x.IsInf() && !x.negative:
+		return z
+	case x.IsInf():
+		z = p
+	case y.IsZero() && x.negative:
+		return p
+	case y.IsZero():
+		return z
+	case x.IsZero():
+		z = halfPi
+	default:
+		z = y.div(x).atan()
+		if x.negative {
+			if y.negative {
+				return z.Sub(p)
+			}
+			return z.Add(p)
+		}
+		return z
+	}
+
+	z.negative = y.negative
+	return z
+}
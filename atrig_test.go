@@ -0,0 +1,107 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import "testing"
+
+func TestAtan(t *testing.T) {
+	tests := []struct {
+		x    string
+		want string
+	}{
+		{"1", "7.853981633974483096156608458198757e-1"},
+		{"0.5", "4.636476090008061162142562314612144e-1"},
+		{"-3", "-1.24904577239825442582991707728109e0"},
+		{"1e-20", "1e-20"},
+		{"12345.678", "1.570715326788431765729558458962911e0"},
+		{"inf", "1.570796326794896619231321691639751e0"},
+		{"-inf", "-1.570796326794896619231321691639751e0"},
+		{"0", "0"},
+		{"nan", "NaN"},
+	}
+
+	for _, tt := range tests {
+		x, _ := ParseReal(tt.x, DefaultPrecision)
+		z := x.Atan()
+		if z.String() != tt.want {
+			t.Fatal("invalid atan", tt.x, z)
+		}
+	}
+}
+
+func TestAsin(t *testing.T) {
+	tests := []struct {
+		x    string
+		want string
+	}{
+		{"0.5", "5.235987755982988730771072305465838e-1"},
+		{"-0.9999999999", "-1.570782184659272770429703474342938e0"},
+		{"1e-10", "1.000000000000000000001666666666667e-10"},
+		{"1", "1.570796326794896619231321691639751e0"},
+		{"-1", "-1.570796326794896619231321691639751e0"},
+		{"1.0000001", "NaN"},
+		{"inf", "NaN"},
+	}
+
+	for _, tt := range tests {
+		x, _ := ParseReal(tt.x, DefaultPrecision)
+		z := x.Asin()
+		if z.String() != tt.want {
+			t.Fatal("invalid asin", tt.x, z)
+		}
+	}
+}
+
+func TestAcos(t *testing.T) {
+	tests := []struct {
+		x    string
+		want string
+	}{
+		{"0.5", "1.047197551196597746154214461093168e0"},
+		{"0.9999999999", "1.414213562384880161821729681325998e-5"},
+		{"-0.5", "2.094395102393195492308428922186335e0"},
+		{"1", "0"},
+		{"-1", "3.141592653589793238462643383279503e0"},
+		{"-2", "NaN"},
+	}
+
+	for _, tt := range tests {
+		x, _ := ParseReal(tt.x, DefaultPrecision)
+		z := x.Acos()
+		if z.String() != tt.want {
+			t.Fatal("invalid acos", tt.x, z)
+		}
+	}
+}
+
+func TestAtan2(t *testing.T) {
+	tests := []struct {
+		y, x string
+		want string
+	}{
+		{"1", "1", "7.853981633974483096156608458198757e-1"},
+		{"1", "-1", "2.356194490192344928846982537459627e0"},
+		{"-1", "-1", "-2.356194490192344928846982537459627e0"},
+		{"-1", "0", "-1.570796326794896619231321691639751e0"},
+		{"0", "-1", "3.141592653589793238462643383279503e0"},
+		{"0", "1", "0"},
+		{"0", "0", "0"},
+		{"inf", "inf", "7.853981633974483096156608458198757e-1"},
+		{"-inf", "-inf", "-2.356194490192344928846982537459627e0"},
+		{"5", "inf", "0"},
+		{"-5", "-inf", "-3.141592653589793238462643383279503e0"},
+		{"-inf", "5", "-1.570796326794896619231321691639751e0"},
+		{"nan", "1", "NaN"},
+	}
+
+	for _, tt := range tests {
+		y, _ := ParseReal(tt.y, DefaultPrecision)
+		x, _ := ParseReal(tt.x, DefaultPrecision)
+		z := y.Atan2(x)
+		if z.String() != tt.want {
+			t.Fatal("invalid atan2", tt.y, tt.x, z)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import "sync"
+
+// Tangent numbers are cached as exact integers and extended as needed. Each
+// Bernoulli number is a small rational function of one of them.
+var tangentCache struct {
+	sync.Mutex
+	t []*Real // t[k] is the kth tangent number; t[0] is unused
+}
+
+// Return the nth Bernoulli number with the given precision, using the
+// convention B₁ = -1/2. A precision of 0 uses the default precision. The result
+// is correctly rounded. Bernoulli numbers are computed exactly as rationals and
+// cached, so later calls for the same or smaller n are cheap.
+func Bernoulli(n int, prec uint) *Real {
+	x := &Real{precision: prec}
+	x.validate()
+	z := initFrom(x)
+	switch {
+	case n < 0:
+		z.form = FormNaN
+	case n == 0:
+		z.SetInt64(1)
+	case n == 1:
+		z.SetInt64(-5)
+		z.exponent = -1
+	case n%2 == 0:
+		num, den := bernoulliFraction(n / 2)
+		z = x.quotient(num, den)
+	}
+	return z
+}
+
+// Return the tangent numbers T₁...Tₙ as exact integers, indexed from 1.
+func tangentNumbers(n int) []*Real {
+	tangentCache.Lock()
+	defer tangentCache.Unlock()
+
+	if len(tangentCache.t) > n {
+		return tangentCache.t[:n+1]
+	}
+
+	// Grow geometrically, since the whole table is recomputed.
+	n = max(n, 2*(len(tangentCache.t)-1))
+
+	// Brent and Harvey, "Fast computation of Bernoulli, Tangent and Secant
+	// numbers", algorithm TangentNumbers.
+	t := make([]*Real, n+1)
+	t[0] = new(Real)
+	t[1] = NewUint64(1)
+	for k := 2; k <= n; k++ {
+		t[k] = mulExact(NewUint64(uint64(k-1)), t[k-1])
+	}
+	for k := 2; k <= n; k++ {
+		for j := k; j <= n; j++ {
+			t[j] = addExact(mulExact(NewUint64(uint64(j-k)), t[j-1]), mulExact(NewUint64(uint64(j-k+2)), t[j]))
+		}
+	}
+	tangentCache.t = t
+	return t
+}
+
+// Return the Bernoulli number B₂ₖ, for k ≥ 1, as the exact fraction num/den,
+// using
+//
+//	B₂ₖ = (-1)^(k-1)·2k·Tₖ / (4^k·(4^k - 1))
+//
+// The fraction is not in lowest terms.
+func bernoulliFraction(k int) (num, den *Real) {
+	t := tangentNumbers(k)[k]
+
+	// 4^k has fewer than 0.61k digits
+	f := NewUint64(4)
+	f.precision = uint(k)*61/100 + 2
+	f = f.ipow(k)
+	den = mulExact(f, addExact(f, NewInt64(-1)))
+
+	num = mulExact(NewUint64(2*uint64(k)), t)
+	if k%2 == 0 {
+		num.negate()
+	}
+	return num, den
+}
+
+// Return the Bernoulli number B₂ₖ, for k ≥ 1, with the precision and rounding
+// mode of x.
+func bernoulli2k(k int, x *Real) *Real {
+	x.validate()
+	num, den := bernoulliFraction(k)
+	num.mode = x.mode
+	num.SetPrecision(x.precision)
+	den.mode = x.mode
+	den.SetPrecision(x.precision)
+	return num.div(den)
+}
+
+// Return the correctly rounded quotient of the exact values num and den, with
+// the precision and rounding mode of x.
+func (x *Real) quotient(num, den *Real) *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		n := num.Copy()
+		n.SetPrecision(w)
+		d := den.Copy()
+		d.SetPrecision(w)
+		q := n.div(d)
+		if mulExact(q, den).Compare(num) == 0 {
+			return q, exactDigits
+		}
+		return q, int(w) - internalPrecisionBuffer
+	})
+}
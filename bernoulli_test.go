@@ -0,0 +1,41 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import "testing"
+
+func TestBernoulli(t *testing.T) {
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{-1, "NaN"},
+		{0, "1e0"},
+		{1, "-5e-1"},
+		{2, "1.666666666666666666666666666666667e-1"},
+		{3, "0"},
+		{4, "-3.333333333333333333333333333333333e-2"},
+		{12, "-2.531135531135531135531135531135531e-1"},
+		{60, "-2.13999492572253336658107447651911e34"},
+		{100, "-2.838224957069370695926415633648176e78"},
+	}
+
+	for _, v := range tests {
+		if z := Bernoulli(v.n, 0); z.String() != v.want {
+			t.Fatal("invalid bernoulli", v.n, z)
+		}
+	}
+}
+
+func TestBernoulliModeZero(t *testing.T) {
+	x := NewInt64(1)
+	x.SetMode(ModeZero)
+	num, den := bernoulliFraction(1)
+	z := x.quotient(num, den)
+
+	if z.String() != "1.666666666666666666666666666666666e-1" {
+		t.Fatal("invalid bernoulli", z)
+	}
+}
@@ -0,0 +1,433 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import (
+	"fmt"
+	"math"
+)
+
+// MaxBesselIterations is the maximum number of terms in the series and
+// asymptotic expansions used for Bessel functions. If this limit is reached,
+// the function will panic.
+const MaxBesselIterations = 100000
+
+// Bessel function kinds
+const (
+	besselJ = iota // first kind, Jν
+	besselY        // second kind, Yν
+	besselI        // modified, first kind, Iν
+	besselK        // modified, second kind, Kν
+)
+
+// Return the Bessel function of the first kind Jν(x). The result is correctly
+// rounded, and has the precision and rounding mode of x. For x < 0, the result
+// is real only for integer orders, and NaN otherwise.
+func BesselJ(nu, x *Real) *Real {
+	return bessel(besselJ, nu, x)
+}
+
+// Return the Bessel function of the second kind Yν(x). The result is correctly
+// rounded, and has the precision and rounding mode of x. Yν(0) is -Inf, and
+// the result is NaN for x < 0.
+func BesselY(nu, x *Real) *Real {
+	return bessel(besselY, nu, x)
+}
+
+// Return the modified Bessel function of the first kind Iν(x). The result is
+// correctly rounded, and has the precision and rounding mode of x. For x < 0,
+// the result is real only for integer orders, and NaN otherwise.
+func BesselI(nu, x *Real) *Real {
+	return bessel(besselI, nu, x)
+}
+
+// Return the modified Bessel function of the second kind Kν(x). The result is
+// correctly rounded, and has the precision and rounding mode of x. Kν(0) is
+// +Inf, and the result is NaN for x < 0.
+func BesselK(nu, x *Real) *Real {
+	return bessel(besselK, nu, x)
+}
+
+// Return the Bessel function of the given kind, correctly rounded to the
+// precision of x. Each evaluation returns its own bound on correct digits,
+// since the digits lost to cancellation depend on the method.
+func bessel(kind int, nu, x *Real) *Real {
+	nu.validate()
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		return x.working(w).bessel(kind, nu)
+	})
+}
+
+func (x *Real) bessel(kind int, nu *Real) (*Real, int) {
+	z := initFrom(x)
+	if x.IsNaN() || nu.form != FormReal {
+		z.form = FormNaN
+		return z, exactDigits
+	}
+
+	n, integer := nu.smallInteger()
+	second := kind == besselY || kind == besselK
+
+	// reflect negative orders
+	if nu.negative && (integer || kind == besselK) {
+		// J₋ₙ = (-1)ⁿJₙ, Y₋ₙ = (-1)ⁿYₙ, I₋ₙ = Iₙ, K₋ν = Kν
+		z, r := x.bessel(kind, nu.Abs())
+		if n%2 != 0 && (kind == besselJ || kind == besselY) {
+			z.negate()
+		}
+		return z, r
+	}
+
+	// reflect negative arguments
+	if x.negative {
+		if second || !integer {
+			z.form = FormNaN
+			return z, exactDigits
+		}
+		// Jₙ(-x) = (-1)ⁿJₙ(x), Iₙ(-x) = (-1)ⁿIₙ(x)
+		z, r := x.Abs().bessel(kind, nu)
+		if n%2 != 0 {
+			z.negate()
+		}
+		return z, r
+	}
+
+	if x.IsInf() {
+		if kind == besselI {
+			z.form = FormInf
+		}
+		return z, exactDigits
+	} else if x.IsZero() {
+		switch {
+		case kind == besselY:
+			z.form = FormInf
+			z.negative = true
+		case kind == besselK:
+			z.form = FormInf
+		case nu.IsZero():
+			z.SetInt64(1)
+		case nu.negative:
+			// (x/2)^ν/Γ(ν+1) diverges with the sign of Γ(ν+1)
+			z.form = FormInf
+			_, sign := addExact(nu, NewInt64(1)).lgamma()
+			z.negative = sign < 0
+		}
+		return z, exactDigits
+	}
+
+	if x.besselAsymptotic(nu) {
+		return x.besselHankel(kind, nu)
+	}
+
+	switch {
+	case !second:
+		return x.besselSeries(nu, kind == besselJ)
+	case integer:
+		return x.besselSecondSeries(n, kind == besselK)
+	default:
+		return x.besselSecondFraction(kind, nu)
+	}
+}
+
+// Returns true if Bessel functions of order ν at x should be computed from
+// their asymptotic expansions for large x, whose smallest term is about
+// e^(-2x). Otherwise the power series is used.
+func (x *Real) besselAsymptotic(nu *Real) bool {
+	if x.exponent > 15 {
+		return true
+	}
+	xf, _ := x.Float64()
+	nf, _ := nu.Float64()
+	return xf > 1.2*float64(x.precision) && xf > nf*nf
+}
+
+// Return the number of decimal digits lost to cancellation when summing terms
+// as large as e^(ax), for a in {0, 1, 2}.
+func cancellationDigits(x *Real, a float64) uint {
+	xf, _ := x.Float64()
+	return uint(a*xf*math.Log10E) + 2
+}
+
+// Return Jν(x), or Iν(x) if alternating is false, for x > 0, using the power
+// series
+//
+//	Σ (∓1)^k (x/2)^(2k+ν) / (k!·Γ(k+ν+1))
+//
+// The terms of the alternating series grow to about e^x before the sum
+// settles, so it is computed with that many more digits.
+func (x *Real) besselSeries(nu *Real, alternating bool) (*Real, int) {
+	p := x.precision + 2*internalPrecisionBuffer
+	if alternating {
+		p += cancellationDigits(x, 1)
+	}
+	xw := x.working(p)
+	nw := nu.working(p)
+
+	h := xw.divUint64(2)
+	h2 := h.mul(h)
+	if alternating {
+		h2.negate()
+	}
+	t := h.powReal(nw)
+	g, _ := nw.AddInt64(1).working(p).gamma()
+	t = t.div(g)
+
+	s := t
+	top := t.exponent
+	nk := nw
+	for k := uint64(1); ; k++ {
+		if k > MaxBesselIterations {
+			panic(fmt.Sprintf("failed to converge bessel(%v, %v)", nu, x))
+		}
+		nk = nk.AddInt64(1)
+		t = t.mul(h2).div(nk).divUint64(k)
+		if t.IsZero() || t.exponent < s.exponent-int(p)-1 {
+			break
+		}
+		s = s.Add(t)
+		top = max(top, t.exponent)
+	}
+
+	// x^ν has an absolute error in its exponent proportional to ν
+	return s, int(p) - 2*internalPrecisionBuffer - (top - s.exponent) - max(nu.exponent+1, 0)
+}
+
+// Return x^ν for x > 0, which is exact for small integer ν.
+func (x *Real) powReal(nu *Real) *Real {
+	if n, ok := nu.smallInteger(); ok {
+		return x.ipow(n)
+	}
+	return nu.working(x.precision).mul(x.ln()).exp()
+}
+
+// Return Yₙ(x), or Kₙ(x) if modified is set, for the integer n ≥ 0 and x > 0,
+// using the power series
+//
+//	Yₙ(x) = (2Σ₁·(ln(x/2)+γ) - F - Σ₂)/π
+//	Kₙ(x) = F/2 + (-1)ⁿ(Σ₂/2 - Σ₁·(ln(x/2)+γ))
+//
+// where uₖ = (∓1)^k (x/2)^(2k+n)/(k!(n+k)!), Σ₁ = Σuₖ, Σ₂ = Σ(Hₖ + Hₙ₊ₖ)uₖ
+// with the harmonic numbers Hₖ, and F = Σ (±1)^k (n-k-1)!/k!·(x/2)^(2k-n) for
+// k in [0, n). The terms grow to about e^x, and for Kₙ the result is about
+// e^-x, so the series is computed with enough extra digits to cover both.
+func (x *Real) besselSecondSeries(n int, modified bool) (*Real, int) {
+	p := x.precision + 2*internalPrecisionBuffer + cancellationDigits(x, 1)
+	if modified {
+		p += cancellationDigits(x, 1)
+	}
+	xw := x.working(p)
+	one := initFrom(xw)
+	one.SetInt64(1)
+
+	h := xw.divUint64(2)
+	hh := h.mul(h)
+	h2 := hh.Copy()
+	if !modified {
+		h2.negate()
+	}
+	l := h.ln().Add(EulerGamma(p))
+
+	// F, from c₀ = (n-1)!·(x/2)^-n and cₖ = cₖ₋₁·(x/2)²/(k(n-k))
+	f := initFrom(xw)
+	top := math.MinInt
+	if n > 0 {
+		c := factorialExact(n - 1)
+		c.SetPrecision(p)
+		c = c.div(h.ipow(n))
+		f = c
+		top = c.exponent
+		for k := 1; k < n; k++ {
+			c = c.mul(hh).divUint64(uint64(k * (n - k)))
+			if modified {
+				c.negate()
+			}
+			f = f.Add(c)
+			top = max(top, c.exponent)
+		}
+	}
+
+	// Σ₁ and Σ₂, from u₀ = (x/2)^n/n!
+	u := h.ipow(n)
+	fn := factorialExact(n)
+	fn.SetPrecision(p)
+	u = u.div(fn)
+	hk := initFrom(xw)
+	hn := initFrom(xw)
+	for k := 1; k <= n; k++ {
+		hn = hn.Add(one.divUint64(uint64(k)))
+	}
+	s1 := u
+	s2 := u.mul(hn)
+	top = max(top, u.exponent+1)
+	for k := uint64(1); ; k++ {
+		if k > MaxBesselIterations {
+			panic(fmt.Sprintf("failed to converge bessel(%v, %v)", n, x))
+		}
+		u = u.mul(h2).divUint64(k * (uint64(n) + k))
+		hk = hk.Add(one.divUint64(k))
+		hn = hn.Add(one.divUint64(uint64(n) + k))
+		v := u.mul(hk.Add(hn))
+		if u.IsZero() || (v.exponent < s2.exponent-int(p)-1 && u.exponent < s1.exponent-int(p)-1) {
+			break
+		}
+		s1 = s1.Add(u)
+		s2 = s2.Add(v)
+		top = max(top, v.exponent, u.exponent+1)
+	}
+
+	var z *Real
+	if modified {
+		z = s2.divUint64(2).Sub(s1.mul(l))
+		if n%2 != 0 {
+			z.negate()
+		}
+		z = f.divUint64(2).Add(z)
+	} else {
+		z = s1.mul(l).mul(NewInt64(2)).Sub(f).Sub(s2).div(pi(xw))
+	}
+	return z, int(p) - internalPrecisionBuffer - 1 - (top - z.exponent)
+}
+
+// Return Yν(x) or Kν(x) for non-integer ν and x > 0 from the functions of the
+// first kind,
+//
+//	Yν(x) = (Jν(x)·cos(νπ) - J₋ν(x)) / sin(νπ)
+//	Kν(x) = π/2·(I₋ν(x) - Iν(x)) / sin(νπ)
+//
+// Near integer ν the difference cancels the digits that sin(νπ) lacks, and
+// for Kν it cancels the e^2x between the size of Iν and Kν, so the functions
+// of the first kind are computed with that many more digits.
+func (x *Real) besselSecondFraction(kind int, nu *Real) (*Real, int) {
+	nw := nu.working(x.precision)
+	s, c := nw.sincosPi()
+
+	p := x.precision + uint(max(-s.exponent, 0)) + internalPrecisionBuffer
+	if kind == besselK {
+		p += cancellationDigits(x, 2)
+	}
+	xw := x.working(p)
+	nw = nu.working(p)
+	s, c = nw.sincosPi()
+	mnu := nw.Copy()
+	mnu.negate()
+
+	var a, b *Real
+	var ra, rb int
+	if kind == besselY {
+		a, ra = xw.besselSeries(nw, true)
+		a = a.mul(c)
+		b, rb = xw.besselSeries(mnu, true)
+	} else {
+		a, ra = xw.besselSeries(mnu, false)
+		b, rb = xw.besselSeries(nw, false)
+	}
+	d := a.Sub(b)
+	r := min(ra, rb) - 1 - (max(a.exponent, b.exponent) - d.exponent)
+
+	z := d.div(s)
+	if kind == besselK {
+		z = z.mul(pi(xw)).divUint64(2)
+	}
+	return z, r
+}
+
+// Return the Bessel function of the given kind for large x > 0 from its
+// asymptotic expansion, with aₖ(ν) = Π(4ν² - (2j-1)²)/(k!·8^k) for j in
+// [1, k]:
+//
+//	Jν(x) = √(2/πx)·(P·cos χ - Q·sin χ)
+//	Yν(x) = √(2/πx)·(P·sin χ + Q·cos χ)
+//	Iν(x) = e^x/√(2πx) · Σ (-1)^k aₖ(ν)/x^k
+//	Kν(x) = √(π/2x)·e^-x · Σ aₖ(ν)/x^k
+//
+// where χ = x - (2ν+1)π/4, P = Σ (-1)^k a₂ₖ(ν)/x^2k, and
+// Q = Σ (-1)^k a₂ₖ₊₁(ν)/x^(2k+1). The expansion is summed until its terms are
+// negligible, or until they start to grow.
+func (x *Real) besselHankel(kind int, nu *Real) (*Real, int) {
+	p := x.precision + internalPrecisionBuffer
+	xw := x.working(p)
+	nw := nu.working(p)
+	one := initFrom(xw)
+	one.SetInt64(1)
+
+	m := nw.mul(nw).mul(NewInt64(4))
+	t := one
+	var sums [2]*Real // even and odd terms
+	sums[0] = one
+	sums[1] = initFrom(xw)
+	last := t
+	r := int(p)
+	for k := uint64(1); ; k++ {
+		if k > MaxBesselIterations {
+			panic(fmt.Sprintf("failed to converge bessel(%v, %v)", nu, x))
+		}
+		j := initFrom(xw)
+		j.SetUint64(2*k - 1)
+		t = t.mul(m.Sub(j.mul(j))).div(xw).divUint64(8 * k)
+		if t.IsZero() || t.exponent < sums[0].exponent-int(p)-1 {
+			break
+		}
+		if t.Abs().Compare(last.Abs()) == 1 {
+			// the smallest term bounds the error
+			r = sums[0].exponent - last.exponent
+			break
+		}
+		last = t
+
+		// signs alternate in pairs for J and Y, and singly for I
+		u := t.Copy()
+		switch kind {
+		case besselJ, besselY:
+			if (k/2)%2 != 0 {
+				u.negate()
+			}
+		case besselI:
+			if k%2 != 0 {
+				u.negate()
+			}
+		}
+		if kind == besselJ || kind == besselY {
+			sums[k%2] = sums[k%2].Add(u)
+		} else {
+			sums[0] = sums[0].Add(u)
+		}
+	}
+
+	two := initFrom(xw)
+	two.SetInt64(2)
+	var z *Real
+	switch kind {
+	case besselJ, besselY:
+		// cos χ and sin χ by rotating x by (2ν+1)·45°
+		sx, cx := xw.sincos()
+		a := nw.mul(two).AddInt64(1).mul(NewInt64(45))
+		sa, ca := a.sincosDeg()
+		cchi := cx.mul(ca).Add(sx.mul(sa))
+		schi := sx.mul(ca).Sub(cx.mul(sa))
+
+		var u, v *Real
+		if kind == besselJ {
+			u = sums[0].mul(cchi)
+			v = sums[1].mul(schi)
+			v.negate()
+		} else {
+			u = sums[0].mul(schi)
+			v = sums[1].mul(cchi)
+		}
+		d := u.Add(v)
+		scale := max(sums[0].exponent, sums[1].exponent)
+		z = two.div(pi(xw).mul(xw)).sqrt().mul(d)
+		r = min(r, int(p)) - internalPrecisionBuffer - (scale - d.exponent)
+	case besselI:
+		z = xw.exp().mul(sums[0]).div(two.mul(pi(xw)).mul(xw).sqrt())
+		r = min(r, int(p)) - internalPrecisionBuffer - max(x.exponent+1, 0)
+	default:
+		e := xw.Copy()
+		e.negate()
+		z = pi(xw).div(two.mul(xw)).sqrt().mul(e.exp()).mul(sums[0])
+		r = min(r, int(p)) - internalPrecisionBuffer - max(x.exponent+1, 0)
+	}
+	return z, r
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestBessel(t *testing.T) {
+	tests := []struct {
+		kind     string
+		nu, x, z string
+	}{
+		{"J", "0", "1", "7.651976865579665514497175261026632e-1"},
+		{"J", "1", "2.5", "4.970941024642740380108162762644222e-1"},
+		{"J", "0.5", "3", "6.500818287737577811400469640462895e-2"},
+		{"J", "-0.5", "3", "-4.560488207946331788468332602116153e-1"},
+		{"J", "3", "-2", "-1.289432494744020510987933329692398e-1"},
+		{"J", "2.5", "1e-5", "1.682088348001425115393275151354279e-14"},
+		{"J", "0", "30", "-8.636798358104021133596232449606395e-2"},
+		{"J", "0", "100", "1.998585030422312242422839095084899e-2"},
+		{"J", "0", "0", "1e0"},
+		{"J", "2", "0", "0"},
+		{"J", "0.5", "-1", "NaN"},
+		{"Y", "0", "1", "8.825696421567695798292676602351516e-2"},
+		{"Y", "2", "3", "-1.604003934849237296757682995379809e-1"},
+		{"Y", "0.3", "2", "3.634828078260922337615496224981288e-1"},
+		{"Y", "-1", "2", "1.070324315409375468883707722774766e-1"},
+		{"Y", "0", "0.001", "-4.471416611375923268980288693426496e0"},
+		{"Y", "0", "30", "-1.172957316866640252512478782318889e-1"},
+		{"Y", "1", "100", "-2.037231200275979330470393266641456e-2"},
+		{"Y", "1", "0", "-∞"},
+		{"I", "0", "1", "1.266065877752008335598244625214718e0"},
+		{"I", "1.5", "4", "8.17263323168659544200166494274781e0"},
+		{"I", "-1.5", "2", "9.849410530002364396971234641549433e-1"},
+		{"I", "2", "30", "7.304368285613803564177701693194163e11"},
+		{"I", "0", "100", "1.073751707131073823519720857603495e42"},
+		{"K", "0", "1", "4.21024438240708333335627379212609e-1"},
+		{"K", "1", "2", "1.39865881816522427284598807035411e-1"},
+		{"K", "0.5", "3", "3.60259851317645925655104564048224e-2"},
+		{"K", "2.5", "10", "2.393132586462788887879411995331446e-5"},
+		{"K", "3", "0.01", "7.99990000124988254567686002506051e6"},
+		{"K", "1", "30", "2.167732001891549424867037833616165e-14"},
+		{"K", "0", "100", "4.656628229175902018939005289483886e-45"},
+		{"K", "0", "0", "∞"},
+	}
+
+	f := map[string]func(nu, x *Real) *Real{
+		"J": BesselJ,
+		"Y": BesselY,
+		"I": BesselI,
+		"K": BesselK,
+	}
+	for _, v := range tests {
+		nu, err := ParseReal(v.nu, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		x, err := ParseReal(v.x, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := f[v.kind](nu, x); z.String() != v.z {
+			t.Fatal("invalid bessel", v.kind, v.nu, v.x, z)
+		}
+	}
+}
+
+func TestBesselHighPrecision(t *testing.T) {
+	nu := NewInt64(1)
+	x, err := ParseReal("2.5", 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	z := BesselY(nu, x)
+
+	if fmt.Sprintf("%.60e", z) != "1.45918137966785798878759940535877571276080196546700999845103e-1" {
+		t.Fatal("invalid bessel", fmt.Sprintf("%.60e", z))
+	}
+}
@@ -4,7 +4,7 @@
 
 package number
 
-import "bytes"
+import "math"
 
 // Compare x with y, returing an integer representing:
 //
@@ -70,9 +70,9 @@ func (x *Real) Compare(y *Real) int {
 
 	// same exponents, just compare the significand
 	if x.negative {
-		return bytes.Compare(y.significand, x.significand)
+		return cmpSignificands(y.significand, x.significand)
 	}
-	return bytes.Compare(x.significand, y.significand)
+	return cmpSignificands(x.significand, y.significand)
 }
 
 // Return a copy of the larger of x and y, or x if the values are equal.
@@ -98,3 +98,44 @@ func (x *Real) Min(y *Real) *Real {
 		return x.Copy()
 	}
 }
+
+// Compare x with the int64 y, returning an integer as in Compare. The
+// comparison is exact and does not allocate.
+func (x *Real) CompareInt64(y int64) int {
+	var buf [3]uint32
+	yr := int64Operand(x, y, &buf)
+	return x.Compare(&yr)
+}
+
+// Compare x with the uint64 y, returning an integer as in Compare. The
+// comparison is exact and does not allocate.
+func (x *Real) CompareUint64(y uint64) int {
+	var buf [3]uint32
+	var yr Real
+	yr.significand, yr.exponent = uint64Significand(y, &buf)
+	return x.Compare(&yr)
+}
+
+// Compare x with the float64 y, returning an integer as in Compare. The
+// comparison is against the exact binary value of y, not a rounded decimal
+// representation, so for example x = 0.1 compares less than y = 0.1.
+func (x *Real) CompareFloat64(y float64) int {
+	if math.IsNaN(y) {
+		panic("cannot compare NaN")
+	}
+
+	var yr Real
+	if math.IsInf(y, 0) {
+		yr.form = FormInf
+		yr.negative = y < 0
+		return x.Compare(&yr)
+	}
+
+	// integers are common and can be compared without the expansion
+	if y == math.Trunc(y) && math.Abs(y) < 1<<63 {
+		return x.CompareInt64(int64(y))
+	}
+
+	yr.setFloat64Exact(y)
+	return x.Compare(&yr)
+}
@@ -4,7 +4,10 @@
 
 package number
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
 
 func TestCompare1(t *testing.T) {
 	x := NewInt64(5)
@@ -105,3 +108,72 @@ func attempt() bool {
 	x.Compare(y)
 	return true
 }
+
+func TestCompareInt64(t *testing.T) {
+	x := NewInt64(-1337)
+
+	if x.CompareInt64(-1337) != 0 {
+		t.Fatal("invalid compare")
+	}
+	if x.CompareInt64(-1338) != 1 {
+		t.Fatal("invalid compare")
+	}
+	if x.CompareInt64(0) != -1 {
+		t.Fatal("invalid compare")
+	}
+	if NewInt64(-9223372036854775808).CompareInt64(-9223372036854775808) != 0 {
+		t.Fatal("invalid compare")
+	}
+	if new(Real).CompareInt64(0) != 0 {
+		t.Fatal("invalid compare")
+	}
+	if NewInt64(1000).CompareInt64(1000) != 0 {
+		t.Fatal("invalid compare")
+	}
+}
+
+func TestCompareUint64(t *testing.T) {
+	x := NewUint64(18446744073709551615)
+
+	if x.CompareUint64(18446744073709551615) != 0 {
+		t.Fatal("invalid compare")
+	}
+	if x.CompareUint64(18446744073709551614) != 1 {
+		t.Fatal("invalid compare")
+	}
+}
+
+func TestCompareFloat64(t *testing.T) {
+	x, _ := ParseReal("0.1", DefaultPrecision)
+
+	// 0.1 as a float64 is slightly larger than 0.1
+	if x.CompareFloat64(0.1) != -1 {
+		t.Fatal("invalid compare")
+	}
+
+	x, _ = ParseReal("0.1000000000000000055511151231257827021181583404541015625", 100)
+	if x.CompareFloat64(0.1) != 0 {
+		t.Fatal("invalid compare")
+	}
+
+	x = NewInt64(-3)
+	if x.CompareFloat64(-3) != 0 {
+		t.Fatal("invalid compare")
+	}
+	if x.CompareFloat64(math.Inf(-1)) != 1 {
+		t.Fatal("invalid compare")
+	}
+	if x.CompareFloat64(math.SmallestNonzeroFloat64) != -1 {
+		t.Fatal("invalid compare")
+	}
+}
+
+func TestCompareInt64Allocs(t *testing.T) {
+	x := NewInt64(12345)
+	n := testing.AllocsPerRun(100, func() {
+		x.CompareInt64(12346)
+	})
+	if n != 0 {
+		t.Fatal("CompareInt64 allocated", n)
+	}
+}
@@ -0,0 +1,355 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import (
+	"fmt"
+	"math"
+	"sync"
+)
+
+// Number of extra digits computed for each constant, so that later rounding
+// to the requested precision is correct in all but pathological cases.
+const constantGuard = 2 * internalPrecisionBuffer
+
+// A mathematical constant, computed on demand and cached at the largest
+// precision requested so far. Requests at a lower or equal precision are
+// rounded from the cached value.
+type constant struct {
+	mu      sync.Mutex
+	value   *Real
+	compute func(p uint) *Real
+}
+
+var (
+	piConstant         = &constant{compute: computePi}
+	eConstant          = &constant{compute: computeE}
+	ln2Constant        = &constant{compute: computeLn2}
+	ln10Constant       = &constant{compute: computeLn10}
+	sqrt2Constant      = &constant{compute: computeSqrt2}
+	phiConstant        = &constant{compute: computePhi}
+	eulerGammaConstant = &constant{compute: computeEulerGamma}
+	catalanConstant    = &constant{compute: computeCatalan}
+)
+
+// Return the constant rounded to precision p with rounding mode m.
+func (c *constant) get(p uint, m int) *Real {
+	if p == 0 {
+		p = DefaultPrecision
+	}
+
+	c.mu.Lock()
+	if c.value == nil || c.value.precision < p+constantGuard {
+		c.value = c.compute(p + constantGuard)
+	}
+	z := c.value.Copy()
+	c.mu.Unlock()
+
+	z.mode = m
+	z.SetPrecision(p)
+	return z
+}
+
+// Return π with the given precision. A precision of 0 uses the default
+// precision.
+func Pi(prec uint) *Real {
+	return piConstant.get(prec, ModeNearestEven)
+}
+
+// Return e, the base of the natural logarithm, with the given precision. A
+// precision of 0 uses the default precision.
+func E(prec uint) *Real {
+	return eConstant.get(prec, ModeNearestEven)
+}
+
+// Return the natural logarithm of 2 with the given precision. A precision of 0
+// uses the default precision.
+func Ln2(prec uint) *Real {
+	return ln2Constant.get(prec, ModeNearestEven)
+}
+
+// Return the natural logarithm of 10 with the given precision. A precision of 0
+// uses the default precision.
+func Ln10(prec uint) *Real {
+	return ln10Constant.get(prec, ModeNearestEven)
+}
+
+// Return the square root of 2 with the given precision. A precision of 0 uses
+// the default precision.
+func Sqrt2(prec uint) *Real {
+	return sqrt2Constant.get(prec, ModeNearestEven)
+}
+
+// Return the golden ratio, (1+√5)/2, with the given precision. A precision of 0
+// uses the default precision.
+func Phi(prec uint) *Real {
+	return phiConstant.get(prec, ModeNearestEven)
+}
+
+// Return the Euler-Mascheroni constant γ with the given precision. A precision
+// of 0 uses the default precision.
+func EulerGamma(prec uint) *Real {
+	return eulerGammaConstant.get(prec, ModeNearestEven)
+}
+
+// Return Catalan's constant with the given precision. A precision of 0 uses the
+// default precision.
+func Catalan(prec uint) *Real {
+	return catalanConstant.get(prec, ModeNearestEven)
+}
+
+// Return π with the precision and rounding mode of x.
+func pi(x *Real) *Real {
+	x.validate()
+	return piConstant.get(x.precision, x.mode)
+}
+
+// Return ln(10) with the precision and rounding mode of x.
+func ln10(x *Real) *Real {
+	x.validate()
+	return ln10Constant.get(x.precision, x.mode)
+}
+
+// Return ln(2) with the precision and rounding mode of x.
+func ln2(x *Real) *Real {
+	x.validate()
+	return ln2Constant.get(x.precision, x.mode)
+}
+
+// Compute π with the Chudnovsky series, summed by binary splitting:
+//
+//	π = 426880·√10005·Q / T
+//
+// Each term adds about 14 digits.
+func computePi(p uint) *Real {
+	n := uint64(float64(p)/14.18) + 2
+	_, q, t := chudnovsky(0, n)
+
+	z := &Real{precision: p}
+	z.SetUint64(10005)
+	z = z.sqrt()
+	c := initFrom(z)
+	c.SetUint64(426880)
+	q.SetPrecision(p)
+	t.SetPrecision(p)
+	return z.mul(c).mul(q).div(t)
+}
+
+// Return the binary splitting terms P, Q, and T of the Chudnovsky series over
+// the terms [a, b).
+func chudnovsky(a, b uint64) (p, q, t *Real) {
+	if b-a == 1 {
+		if a == 0 {
+			return NewUint64(1), NewUint64(1), NewUint64(13591409)
+		}
+		p = mulExact(mulExact(NewUint64(6*a-5), NewUint64(2*a-1)), NewUint64(6*a-1))
+		q = mulExact(NewUint64(a*a*a), NewUint64(10939058860032000)) // 640320³/24
+		t = mulExact(p, NewUint64(13591409+545140134*a))
+		if a%2 == 1 {
+			t.negate()
+		}
+		return p, q, t
+	}
+
+	m := (a + b) / 2
+	p1, q1, t1 := chudnovsky(a, m)
+	p2, q2, t2 := chudnovsky(m, b)
+	return mulExact(p1, p2), mulExact(q1, q2), addExact(mulExact(t1, q2), mulExact(p1, t2))
+}
+
+// Compute e = Σ 1/k!, summed by binary splitting.
+func computeE(p uint) *Real {
+	// find n such that n! > 10^p
+	var n uint64
+	for f := 0.0; f <= float64(p)+1; {
+		n++
+		f += math.Log10(float64(n))
+	}
+
+	s, q := eSeries(0, n)
+	one := &Real{precision: p}
+	one.SetUint64(1)
+	s.SetPrecision(p)
+	q.SetPrecision(p)
+	return one.Add(s.div(q))
+}
+
+// Return S and Q such that S/Q = Σ 1/((a+1)(a+2)...(k)) for k in (a, b].
+func eSeries(a, b uint64) (s, q *Real) {
+	if b-a == 1 {
+		return NewUint64(1), NewUint64(b)
+	}
+
+	m := (a + b) / 2
+	s1, q1 := eSeries(a, m)
+	s2, q2 := eSeries(m, b)
+	return addExact(mulExact(s1, q2), s2), mulExact(q1, q2)
+}
+
+// Compute ln(2) = 14·atanh(1/31) + 10·atanh(1/49) + 6·atanh(1/161).
+func computeLn2(p uint) *Real {
+	return atanhCombination(p, 14, 10, 6)
+}
+
+// Compute ln(10) = 46·atanh(1/31) + 34·atanh(1/49) + 20·atanh(1/161).
+func computeLn10(p uint) *Real {
+	return atanhCombination(p, 46, 34, 20)
+}
+
+// Return a·atanh(1/31) + b·atanh(1/49) + c·atanh(1/161) with precision p.
+func atanhCombination(p uint, a, b, c int64) *Real {
+	x := &Real{precision: p + internalPrecisionBuffer}
+	z := initFrom(x)
+	for i, k := range []uint64{31, 49, 161} {
+		m := initFrom(x)
+		m.SetInt64([]int64{a, b, c}[i])
+		z = z.Add(atanhInverse(x, k).mul(m))
+	}
+	z.SetPrecision(p)
+	return z
+}
+
+// Return atanh(1/k), for the integer k > 1, with the precision and rounding
+// mode of x.
+func atanhInverse(x *Real, k uint64) *Real {
+	one := initFrom(x)
+	one.SetInt64(1)
+	t := one.divUint64(k)
+	z := t
+	for n := uint64(1); ; n++ {
+		t = t.divUint64(k * k)
+		u := t.divUint64(2*n + 1)
+		if u.exponent < z.exponent-int(x.precision)-1 {
+			return z
+		}
+		z = z.Add(u)
+	}
+}
+
+// Compute √2.
+func computeSqrt2(p uint) *Real {
+	z := &Real{precision: p}
+	z.SetUint64(2)
+	return z.sqrt()
+}
+
+// Compute (1+√5)/2.
+func computePhi(p uint) *Real {
+	z := &Real{precision: p}
+	z.SetUint64(5)
+	one := initFrom(z)
+	one.SetUint64(1)
+	return z.sqrt().Add(one).divUint64(2)
+}
+
+// Compute γ with the Brent-McMillan algorithm. With
+//
+//	B₀ = 1, Bₖ = Bₖ₋₁·n²/k²
+//	A₀ = -ln(n), Aₖ = (Aₖ₋₁·n²/k + Bₖ)/k
+//
+// γ = ΣAₖ/ΣBₖ with an error below e^(-4n). n is chosen to be a power of two
+// so that ln(n) is a multiple of ln(2).
+func computeEulerGamma(p uint) *Real {
+	var j uint64
+	for math.Ldexp(1, int(j)) < float64(p)*math.Ln10/4+1 {
+		j++
+	}
+	n := uint64(1) << j
+
+	// The terms grow to about e^(2n) before decaying, so carry enough
+	// digits to keep their sum accurate.
+	x := &Real{precision: p + uint(digits(int(p))) + internalPrecisionBuffer}
+
+	nn := initFrom(x)
+	nn.SetUint64(n * n)
+	a := initFrom(x)
+	a.SetUint64(j)
+	a = a.mul(ln2(x))
+	a.negate()
+	b := initFrom(x)
+	b.SetUint64(1)
+	u := a
+	v := b
+
+	for k := uint64(1); ; k++ {
+		b = b.mul(nn).divUint64(k * k)
+		a = a.mul(nn).divUint64(k).Add(b).divUint64(k)
+		u = u.Add(a)
+		v = v.Add(b)
+
+		if k > n && b.exponent < v.exponent-int(x.precision)-1 && a.exponent < u.exponent-int(x.precision)-1 {
+			break
+		}
+		if k > 8*n+MaxTrigIterations {
+			panic(fmt.Sprintf("failed to converge EulerGamma(%v)", p))
+		}
+	}
+
+	z := u.div(v)
+	z.SetPrecision(p)
+	return z
+}
+
+// Compute Catalan's constant with Ramanujan's series,
+//
+//	G = π/8·ln(2+√3) + 3/8·Σ (k!)²/((2k)!(2k+1)²)
+//
+// where ln(2+√3) = 2·atanh(1/√3).
+func computeCatalan(p uint) *Real {
+	x := &Real{precision: p + uint(digits(int(p))) + internalPrecisionBuffer}
+
+	// t = (k!)²/(2k)!
+	t := initFrom(x)
+	t.SetUint64(1)
+	s := t
+	k1 := initFrom(x)
+	for k := uint64(0); ; k++ {
+		k1.SetUint64(k + 1)
+		t = t.mul(k1).divUint64(2 * (2*k + 1))
+		u := t.divUint64((2*k + 3) * (2*k + 3))
+		if u.exponent < s.exponent-int(x.precision)-1 {
+			break
+		}
+		s = s.Add(u)
+	}
+	three := initFrom(x)
+	three.SetUint64(3)
+	s = s.mul(three).divUint64(8)
+
+	// atanh(1/√3) = Σ 3^-n/((2n+1)√3)
+	t = initFrom(x)
+	t.SetUint64(1)
+	l := t
+	for n := uint64(1); ; n++ {
+		t = t.divUint64(3)
+		u := t.divUint64(2*n + 1)
+		if u.exponent < l.exponent-int(x.precision)-1 {
+			break
+		}
+		l = l.Add(u)
+	}
+	l = l.div(three.sqrt())
+
+	z := pi(x).mul(l).divUint64(4).Add(s)
+	z.SetPrecision(p)
+	return z
+}
+
+// Return the exact product of the integers x and y.
+func mulExact(x, y *Real) *Real {
+	return x.working(uint(x.ndigits()+y.ndigits()) + 1).mul(y)
+}
+
+// Return the exact sum of the integers x and y.
+func addExact(x, y *Real) *Real {
+	if x.IsZero() {
+		return y.Copy()
+	} else if y.IsZero() {
+		return x.Copy()
+	}
+	hi := max(x.exponent, y.exponent) + 1
+	lo := min(x.exponent-x.ndigits(), y.exponent-y.ndigits()) + 1
+	return x.working(uint(hi-lo) + 1).Add(y)
+}
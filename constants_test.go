@@ -0,0 +1,67 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestConstants(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(uint) *Real
+		want string
+	}{
+		{"pi", Pi, "3.1415926535897932384626433832795028841971693993751e0"},
+		{"e", E, "2.7182818284590452353602874713526624977572470937e0"},
+		{"ln2", Ln2, "6.9314718055994530941723212145817656807550013436026e-1"},
+		{"ln10", Ln10, "2.3025850929940456840179914546843642076011014886288e0"},
+		{"sqrt2", Sqrt2, "1.4142135623730950488016887242096980785696718753769e0"},
+		{"phi", Phi, "1.6180339887498948482045868343656381177203091798058e0"},
+		{"gamma", EulerGamma, "5.7721566490153286060651209008240243104215933593992e-1"},
+		{"catalan", Catalan, "9.1596559417721901505460351493238411077414937428167e-1"},
+	}
+
+	for _, v := range tests {
+		z := v.f(50)
+		if s := fmt.Sprintf("%.50e", z); s != v.want {
+			t.Fatal("invalid", v.name, s)
+		}
+		if z.Precision() != 50 {
+			t.Fatal("invalid precision", v.name, z.Precision())
+		}
+	}
+
+	if z := Pi(0); z.String() != "3.141592653589793238462643383279503e0" {
+		t.Fatal("invalid pi", z)
+	}
+}
+
+func TestConstantsHighPrecision(t *testing.T) {
+	// Beyond the digits that used to be stored in tables.
+	s := fmt.Sprintf("%.1100e", Ln10(1100))
+	if s[len(s)-15:] != "5984383191913e0" {
+		t.Fatal("invalid ln10", s)
+	}
+}
+
+func TestConstantsConcurrent(t *testing.T) {
+	want := fmt.Sprintf("%.40e", Catalan(40))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(p uint) {
+			defer wg.Done()
+			z := Catalan(p)
+			if s := fmt.Sprintf("%.40e", z); p >= 40 && s != want {
+				t.Error("invalid catalan", p, s)
+			}
+		}(uint(20 + 10*i))
+	}
+	wg.Wait()
+}
@@ -63,16 +63,21 @@ func (x *Real) Format(s fmt.State, verb rune) {
 		// decimal -- rounds to the precision of digits left of the decimal place
 		if printable.exponent < 0 {
 			printable.SetUint64(0)
-		} else if len(printable.significand)-1 > printable.exponent {
+		} else if printable.ndigits()-1 > printable.exponent {
 			printable.SetPrecision(uint(printable.exponent) + 1)
 		}
-		if len(printable.significand) == 0 {
+		if MaxFormatDigits != 0 && printable.expandedLength() > MaxFormatDigits {
+			writeLimitError(s, verb)
+			return
+		}
+		d := printable.significandDigits()
+		if len(d) == 0 {
 			o.WriteString("0")
 		} else {
-			for _, v := range printable.significand {
+			for _, v := range d {
 				o.WriteString(fmt.Sprintf("%c", v+asciiOffset))
 			}
-			trailing := printable.exponent - len(printable.significand) + 1
+			trailing := printable.exponent - len(d) + 1
 			for i := 0; i < trailing; i++ {
 				o.WriteString("0")
 			}
@@ -81,15 +86,16 @@ func (x *Real) Format(s fmt.State, verb rune) {
 		printable.SetPrecision(uint(p))
 
 		// scientific notation
-		if len(printable.significand) == 0 {
+		d := printable.significandDigits()
+		if len(d) == 0 {
 			o.WriteString("0")
 		} else {
-			o.WriteString(fmt.Sprintf("%c", printable.significand[0]+asciiOffset))
+			o.WriteString(fmt.Sprintf("%c", d[0]+asciiOffset))
 
-			if len(printable.significand) > 1 {
+			if len(d) > 1 {
 				o.WriteString(".")
 
-				for _, v := range printable.significand[1:] {
+				for _, v := range d[1:] {
 					o.WriteString(fmt.Sprintf("%c", v+asciiOffset))
 				}
 			}
@@ -97,9 +103,14 @@ func (x *Real) Format(s fmt.State, verb rune) {
 		}
 	case 'f':
 		printable.SetPrecision(uint(p))
+		if MaxFormatDigits != 0 && printable.expandedLength() > MaxFormatDigits {
+			writeLimitError(s, verb)
+			return
+		}
 
 		// floating point notation
-		if len(printable.significand) == 0 {
+		d := printable.significandDigits()
+		if len(d) == 0 {
 			o.WriteString("0.0")
 		} else {
 			if printable.exponent < 0 {
@@ -108,25 +119,25 @@ func (x *Real) Format(s fmt.State, verb rune) {
 					o.WriteString("0")
 					printable.exponent++
 				}
-				for _, v := range printable.significand {
+				for _, v := range d {
 					o.WriteString(fmt.Sprintf("%c", v+asciiOffset))
 				}
 			} else {
-				for printable.exponent >= 0 && len(printable.significand) > 0 {
-					o.WriteString(fmt.Sprintf("%c", printable.significand[0]+asciiOffset))
-					printable.significand = printable.significand[1:]
+				for printable.exponent >= 0 && len(d) > 0 {
+					o.WriteString(fmt.Sprintf("%c", d[0]+asciiOffset))
+					d = d[1:]
 					printable.exponent--
 				}
 				if printable.exponent >= 0 {
 					// trailing zeros in the integer part
-					trailing := printable.exponent - len(printable.significand) + 1
+					trailing := printable.exponent - len(d) + 1
 					for i := 0; i < trailing; i++ {
 						o.WriteString("0")
 					}
 				}
 				o.WriteString(".")
-				if len(printable.significand) != 0 {
-					for _, v := range printable.significand {
+				if len(d) != 0 {
+					for _, v := range d {
 						o.WriteString(fmt.Sprintf("%c", v+asciiOffset))
 					}
 				} else {
@@ -137,7 +148,7 @@ func (x *Real) Format(s fmt.State, verb rune) {
 	case 'v':
 		o.Reset()
 		// attempt a natural notation based on the value
-		if !precisionSet && abs(printable.exponent)+len(printable.significand) > sensibleSize {
+		if !precisionSet && abs(printable.exponent)+printable.ndigits() > sensibleSize {
 			// scientific notation
 			printable.SetPrecision(uint(p))
 			o.WriteString(fmt.Sprintf("%.*e", printable.precision, printable))
@@ -156,6 +167,25 @@ func (x *Real) Format(s fmt.State, verb rune) {
 	s.Write(o.Bytes())
 }
 
+// Return the number of digits needed to write x without an exponent.
+func (x *Real) expandedLength() int {
+	n := x.ndigits()
+	if n == 0 {
+		return 1
+	} else if x.exponent < 0 {
+		return n - x.exponent
+	} else if x.exponent >= n {
+		return x.exponent + 1
+	}
+	return n
+}
+
+// Write an error in place of a number that would expand beyond
+// MaxFormatDigits.
+func writeLimitError(s fmt.State, verb rune) {
+	fmt.Fprintf(s, "%%!%c(number: exceeds MaxFormatDigits)", verb)
+}
+
 // Return the integer part of a real number by truncating.
 func (x *Real) Integer() *Real {
 	z := x.Copy()
@@ -168,8 +198,9 @@ func (x *Real) Integer() *Real {
 
 	if z.exponent < 0 {
 		z.SetUint64(0)
-	} else if z.exponent < len(x.significand)-1 {
-		z.significand = z.significand[:z.exponent+1]
+	} else if n := z.ndigits(); z.exponent < n-1 {
+		z.significand = z.significand.shr(n - z.exponent - 1)
+		z.trim()
 	}
 	return z
 }
@@ -224,6 +255,17 @@ func (x *Real) Float64() (float64, error) {
 // Input can be as a fixed precision number or in scientific notation, using a
 // lower case 'e' for the exponent.
 func ParseReal(s string, p uint) (*Real, error) {
+	return ParseRealLimits(s, p, Limits{})
+}
+
+// ParseRealLimits is the same as ParseReal, but returns an error if the input
+// exceeds the given limits. It should be preferred over ParseReal for
+// untrusted input.
+func ParseRealLimits(s string, p uint, l Limits) (*Real, error) {
+	if err := l.checkPrecision(p); err != nil {
+		return nil, err
+	}
+
 	s = strings.ToLower(s)
 
 	x := new(Real)
@@ -245,17 +287,21 @@ func ParseReal(s string, p uint) (*Real, error) {
 	}
 
 	// significand
+	var d []byte
 	var radixSet bool
 	var oneDigit bool
 	for len(s) > 0 {
 		if s[0] == '.' {
 			radixSet = true
-			x.exponent = len(x.significand) - 1
+			x.exponent = len(d) - 1
 		} else if s[0] >= '0' && s[0] <= '9' {
-			x.significand = append(x.significand, byte(s[0])-asciiOffset)
+			d = append(d, byte(s[0])-asciiOffset)
+			if err := l.checkDigits(len(d)); err != nil {
+				return nil, err
+			}
 		} else if s[0] == 'e' {
 			// exponent
-			if len(x.significand) == 0 {
+			if len(d) == 0 {
 				return nil, ErrInvalidCharacter
 			}
 			break
@@ -271,7 +317,7 @@ func ParseReal(s string, p uint) (*Real, error) {
 	}
 
 	if !radixSet {
-		x.exponent = len(x.significand) - 1
+		x.exponent = len(d) - 1
 	}
 
 	// optional exponent
@@ -284,9 +330,22 @@ func ParseReal(s string, p uint) (*Real, error) {
 		if err != nil {
 			return nil, err
 		}
+		// Normalizing the significand moves the exponent by at most
+		// its length, so anything further out is rejected here before
+		// the sum can overflow.
+		if l.MaxExponent != 0 && abs(int(exp)) > l.MaxExponent+len(d) {
+			return nil, ErrExponentRange
+		}
 		x.exponent += int(exp)
 	}
 
+	x.setSignificandDigits(d)
+	if !x.IsZero() {
+		if err := l.checkExponent(x.exponent); err != nil {
+			return nil, err
+		}
+	}
+
 	x.SetPrecision(p)
 	return x, nil
 }
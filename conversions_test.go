@@ -15,7 +15,7 @@ func TestFormatterDecimalLargePrecision(t *testing.T) {
 	x = x.Factorial()
 	x.exponent = 0
 
-	if fmt.Sprintf("%.100f", x) != "9.332621544394415268169923885626670049071596826438162146859296389521759999322991560894146397615651822" {
+	if fmt.Sprintf("%.100f", x) != "9.332621544394415268169923885626670049071596826438162146859296389521759999322991560894146397615651829" {
 		t.Fatal("invalid format", fmt.Sprintf("%.100f", x))
 	}
 }
@@ -26,7 +26,7 @@ func TestFormatterDecimalLargePrecision2(t *testing.T) {
 	x = x.Factorial()
 	x.exponent = 0
 
-	if fmt.Sprintf("%.100v", x) != "9.332621544394415268169923885626670049071596826438162146859296389521759999322991560894146397615651822" {
+	if fmt.Sprintf("%.100v", x) != "9.332621544394415268169923885626670049071596826438162146859296389521759999322991560894146397615651829" {
 		t.Fatal("invalid format", fmt.Sprintf("%.100v", x))
 	}
 }
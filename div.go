@@ -16,6 +16,16 @@ func (x *Real) Div(y *Real) *Real {
 	return z
 }
 
+// Return the quotient of x/y for the int64 y. The result has the precision and
+// rounding mode of x. Unlike x.Div(NewInt64(y)), no temporary Real is
+// allocated for y.
+func (x *Real) DivInt64(y int64) *Real {
+	x.validate()
+	var buf [3]uint32
+	yr := int64Operand(x, y, &buf)
+	return x.Div(&yr)
+}
+
 func (x *Real) div(y *Real) *Real {
 	z := initFrom2(x, y)
 	if x.IsInf() && y.IsInf() {
@@ -41,6 +51,37 @@ func (x *Real) div(y *Real) *Real {
 	return x.mul(yr)
 }
 
+// Return the quotient of x/d for an integer 0 < d ≤ 10^18 using short
+// division, which is much faster than multiplying by a reciprocal. The result
+// has the precision and rounding mode of x.
+func (x *Real) divUint64(d uint64) *Real {
+	x.validate()
+	z := initFrom(x)
+	if x.form != FormReal {
+		z.form = x.form
+		z.negative = x.negative
+		return z
+	} else if x.IsZero() {
+		return z
+	}
+
+	// Scale the significand so the quotient has two digits beyond the
+	// precision, plus a sticky digit if anything remains, so that
+	// rounding is exact.
+	n := x.ndigits()
+	k := max(int(x.precision)+3+digits(int(d))-n, 0)
+	q, r := x.significand.shl(k).divUint64(d)
+	if r != 0 {
+		q = q.shl(1).inc()
+		k++
+	}
+	z.significand = q
+	z.exponent = x.exponent - n - k + q.ndigits()
+	z.negative = x.negative
+	z.round()
+	return z
+}
+
 // Return the modulus x%y. If either x or y are not integers, they will be
 // truncated before the operation.
 func (x *Real) Mod(y *Real) *Real {
@@ -125,8 +125,8 @@ func TestDivZero(t *testing.T) {
 func BenchmarkDiv(b *testing.B) {
 	x := new(Real)
 	y := new(Real)
-	x.significand = []byte{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9}
-	y.significand = []byte{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9}
+	x.setSignificandDigits([]byte{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9})
+	y.setSignificandDigits([]byte{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9})
 	x.validate()
 	y.validate()
 	for b.Loop() {
@@ -167,3 +167,35 @@ func TestModInf(t *testing.T) {
 		t.Fatal("invalid mod", m)
 	}
 }
+
+func TestDivInt64(t *testing.T) {
+	x := NewInt64(1)
+
+	z := x.DivInt64(-8)
+	if z.String() != "-1.25e-1" {
+		t.Fatal("invalid div", z)
+	}
+}
+
+func TestDivUint64(t *testing.T) {
+	tests := []struct {
+		x    string
+		d    uint64
+		want string
+	}{
+		{"1", 7, "1.428571428571428571428571428571429e-1"},
+		{"-2.5e-10", 3, "-8.333333333333333333333333333333333e-11"},
+		{"1e40", 239, "4.18410041841004184100418410041841e37"},
+		{"1e40", 8, "1.25e39"},
+	}
+
+	for _, v := range tests {
+		x, err := ParseReal(v.x, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := x.divUint64(v.d); z.String() != v.want {
+			t.Fatal("invalid div", v.x, z)
+		}
+	}
+}
@@ -30,5 +30,25 @@ Real currently supports three rounding modes:
 
 The default precision is 34, which is equivalent to IEEE-754-2008 128-bit
 decimal floating point numbers.
+
+Elementary functions such as Exp, Ln, Log10, Pow, Sqrt, and the trigonometric
+functions are correctly rounded in every rounding mode. They are computed with
+an error bound and retried at a higher working precision whenever the result is
+too close to a rounding boundary to round with confidence. The same holds for
+special functions such as Gamma, LogGamma, Erf, Erfc, Zeta, and the Bessel
+functions.
+
+The working precision is raised to at most four times its initial value, which
+is the precision plus 10 digits, or 44 digits for precisions below 34. A result
+that still can't be rounded with confidence lies within about one part in
+10^(4p) of a rounding boundary, where p is the precision. Exact results are
+detected and rounded exactly, so this only happens for inexact results that lie
+that close to a boundary, or for exact results that aren't detected as such.
+In that case the most precise approximation is rounded, and the result may be
+one unit in the last place from the correctly rounded one.
+
+Mathematical constants such as Pi, E, and Ln2 are computed on demand to any
+precision, and cached so that later requests at the same or lower precision are
+free.
 */
 package number
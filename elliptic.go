@@ -0,0 +1,154 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import "fmt"
+
+// MaxAGMIterations is the maximum number of iterations of the
+// arithmetic-geometric mean. Convergence is quadratic, so this is only reached
+// for arguments of wildly different magnitude. If this limit is reached, the
+// function will panic.
+const MaxAGMIterations = 10000
+
+// Return the arithmetic-geometric mean of x and y, the common limit of
+//
+//	aₙ₊₁ = (aₙ + bₙ)/2, bₙ₊₁ = √(aₙbₙ)
+//
+// starting from x and y. The result is correctly rounded, and has the
+// precision and rounding mode of x. It is NaN if x and y have opposite signs.
+func (x *Real) AGM(y *Real) *Real {
+	y.validate()
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		z, exact := x.working(w).agm(y)
+		if exact {
+			return z, exactDigits
+		}
+		return z, int(w) - internalPrecisionBuffer - 2
+	})
+}
+
+func (x *Real) agm(y *Real) (*Real, bool) {
+	z := initFrom(x)
+	if x.IsNaN() || y.IsNaN() || (x.negative != y.negative && !x.IsZero() && !y.IsZero()) {
+		z.form = FormNaN
+		return z, true
+	} else if x.IsZero() || y.IsZero() {
+		if x.IsInf() || y.IsInf() {
+			z.form = FormNaN
+		}
+		return z, true
+	} else if x.IsInf() || y.IsInf() {
+		z.form = FormInf
+		z.negative = x.negative
+		return z, true
+	} else if x.Compare(y) == 0 {
+		z.CopyValue(x)
+		return z, true
+	}
+
+	// agm(-x, -y) = -agm(x, y)
+	z, _ = x.Abs().agmSum(y.working(x.precision).Abs())
+	z.negative = x.negative
+	return z, false
+}
+
+// Return the arithmetic-geometric mean of x, y > 0, along with
+//
+//	Σ 2^(n-1)·cₙ², where cₙ = (aₙ₋₁ - bₙ₋₁)/2, for n ≥ 1
+//
+// which gives the complete elliptic integral of the second kind.
+func (x *Real) agmSum(y *Real) (a, s *Real) {
+	a, b := x, y
+	s = initFrom(x)
+	f := initFrom(x)
+	f.SetInt64(1)
+	for i := 0; ; i++ {
+		if i > MaxAGMIterations {
+			panic(fmt.Sprintf("failed to converge agm(%v, %v)", x, y))
+		}
+		c := a.Sub(b).divUint64(2)
+		a, b = a.Add(b).divUint64(2), a.mul(b).sqrt()
+		s = s.Add(f.mul(c).mul(c))
+		f = f.Add(f)
+
+		// Once c is below half the precision, the next difference is
+		// below all of it, and a is the mean to the last digit.
+		if c.IsZero() || c.exponent < a.exponent-int(x.precision)/2-1 {
+			return a, s
+		}
+	}
+}
+
+// Return the complete elliptic integral of the first kind,
+//
+//	K(m) = ∫ 1/√(1 - m·sin²θ) dθ, for θ in [0, π/2]
+//
+// with the parameter m = k². The result is correctly rounded. K(1) is +Inf,
+// and K is NaN for m > 1.
+func (x *Real) EllipticK() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		z := x.working(w).ellipticK()
+		return z, int(w) - internalPrecisionBuffer - 2
+	})
+}
+
+// K(m) = π / (2·agm(1, √(1-m)))
+func (x *Real) ellipticK() *Real {
+	z := initFrom(x)
+	c := x.Compare(NewInt64(1))
+	if x.IsNaN() || c == 1 {
+		z.form = FormNaN
+		return z
+	} else if c == 0 {
+		z.form = FormInf
+		return z
+	} else if x.IsInf() {
+		return z
+	}
+
+	one := initFrom(x)
+	one.SetInt64(1)
+	a, _ := one.agmSum(x.reflect().sqrt())
+	return pi(x).div(a.Add(a))
+}
+
+// Return the complete elliptic integral of the second kind,
+//
+//	E(m) = ∫ √(1 - m·sin²θ) dθ, for θ in [0, π/2]
+//
+// with the parameter m = k². The result is correctly rounded. E(1) is 1, and E
+// is NaN for m > 1.
+func (x *Real) EllipticE() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		z, r := x.working(w).ellipticE()
+		return z, int(w) - internalPrecisionBuffer - 2 - r
+	})
+}
+
+// E(m) = K(m)·(1 - m/2 - Σ 2^(n-1)·cₙ²), using the sum from the
+// arithmetic-geometric mean for K(m). Also return the number of digits lost
+// to cancellation in the sum, which grows as m approaches 1.
+func (x *Real) ellipticE() (*Real, int) {
+	z := initFrom(x)
+	c := x.Compare(NewInt64(1))
+	if x.IsNaN() || c == 1 {
+		z.form = FormNaN
+		return z, 0
+	} else if c == 0 {
+		z.SetInt64(1)
+		return z, 0
+	} else if x.IsInf() {
+		z.form = FormInf
+		return z, 0
+	}
+
+	one := initFrom(x)
+	one.SetInt64(1)
+	a, s := one.agmSum(x.reflect().sqrt())
+	s = s.Add(x.divUint64(2))
+	d := one.Sub(s)
+	k := pi(x).div(a.Add(a))
+	return k.mul(d), max(s.exponent-d.exponent, 0)
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestAGM(t *testing.T) {
+	tests := []struct {
+		x, y, want string
+	}{
+		{"1", "2", "1.456791031046906869186432383265082e0"},
+		{"24", "6", "1.34581714817256154207668131569744e1"},
+		{"1", "0.5", "7.28395515523453434593216191632541e-1"},
+		{"1", "1e-100", "6.781055745575450882428550301460597e-3"},
+		{"-1", "-2", "-1.456791031046906869186432383265082e0"},
+		{"3", "3", "3e0"},
+		{"0", "5", "0"},
+		{"1", "-2", "NaN"},
+		{"inf", "2", "∞"},
+		{"inf", "0", "NaN"},
+	}
+
+	for _, v := range tests {
+		x, err := ParseReal(v.x, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		y, err := ParseReal(v.y, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := x.AGM(y); z.String() != v.want {
+			t.Fatal("invalid agm", v.x, v.y, z)
+		}
+	}
+}
+
+func TestElliptic(t *testing.T) {
+	tests := []struct {
+		m, k, e string
+	}{
+		{"0", "1.570796326794896619231321691639751e0", "1.570796326794896619231321691639751e0"},
+		{"1e-20", "1.570796326794896619235248682456739e0", "1.570796326794896619227394700822764e0"},
+		{"0.5", "1.85407467730137191843385034719526e0", "1.350643881047675502520174735338726e0"},
+		{"0.9", "2.578092113348173188202570771816506e0", "1.104774732704073326090398867147473e0"},
+		{"0.999999999999", "1.520180491908771517417218598589459e1", "1.000000000007350902459544729574894e0"},
+		{"-1", "1.31102877714605990523241979494556e0", "1.910098894513856008952381041085722e0"},
+		{"-1e100", "1.165155490108221748197340369771346e-48", "1e50"},
+		{"1", "∞", "1e0"},
+		{"2", "NaN", "NaN"},
+		{"-inf", "0", "∞"},
+	}
+
+	for _, v := range tests {
+		m, err := ParseReal(v.m, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := m.EllipticK(); z.String() != v.k {
+			t.Fatal("invalid elliptic k", v.m, z)
+		}
+		if z := m.EllipticE(); z.String() != v.e {
+			t.Fatal("invalid elliptic e", v.m, z)
+		}
+	}
+}
+
+func TestEllipticHighPrecision(t *testing.T) {
+	m, err := ParseReal("0.5", 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	z := m.EllipticE()
+
+	if fmt.Sprintf("%.60e", z) != "1.35064388104767550252017473533872584134952236692435454532325e0" {
+		t.Fatal("invalid elliptic e", fmt.Sprintf("%.60e", z))
+	}
+}
@@ -0,0 +1,308 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import (
+	"fmt"
+	"math"
+)
+
+// MaxErfIterations is the maximum number of terms in the series and continued
+// fraction used for the error function, and of iterations used to invert it.
+// If this limit is reached, the function will panic.
+const MaxErfIterations = 100000
+
+// Return the error function of x, erf(x) = 2/√π ∫₀ˣ e^(-t²) dt. The result is
+// correctly rounded.
+func (x *Real) Erf() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		z := x.working(w).erf()
+		return z, int(w) - internalPrecisionBuffer - erfLoss(x)
+	})
+}
+
+func (x *Real) erf() *Real {
+	z := initFrom(x)
+	if x.IsNaN() {
+		z.form = FormNaN
+		return z
+	} else if x.IsInf() {
+		z.SetInt64(1)
+		z.negative = x.negative
+		return z
+	} else if x.IsZero() {
+		return z
+	}
+
+	a := x.Abs()
+	if a.erfcFraction() {
+		one := initFrom(x)
+		one.SetInt64(1)
+		z = one.Sub(a.erfcContinuedFraction())
+	} else {
+		z = a.erfSeries()
+	}
+	if x.negative {
+		z.negate()
+	}
+	return z
+}
+
+// Return the complementary error function of x, erfc(x) = 1 - erf(x). The
+// result is correctly rounded, and stays accurate far into the tail where
+// erf(x) is indistinguishable from 1.
+func (x *Real) Erfc() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		z := x.working(w).erfc()
+		return z, int(w) - internalPrecisionBuffer - erfLoss(x)
+	})
+}
+
+func (x *Real) erfc() *Real {
+	z := initFrom(x)
+	if x.IsNaN() {
+		z.form = FormNaN
+		return z
+	} else if x.IsInf() {
+		if x.negative {
+			z.SetInt64(2)
+		}
+		return z
+	} else if x.IsZero() {
+		z.SetInt64(1)
+		return z
+	}
+
+	if x.negative {
+		// erfc(-x) = 2 - erfc(x)
+		two := initFrom(x)
+		two.SetInt64(2)
+		return two.Sub(x.Abs().erfc())
+	} else if x.erfcFraction() {
+		return x.erfcContinuedFraction()
+	}
+
+	// erfc(x) is about e^(-x²), so computing it as 1 - erf(x) cancels
+	// x²/ln(10) leading digits. Compute erf(x) with that many more.
+	f, _ := x.Float64()
+	a := x.working(x.precision + uint(f*f/math.Ln10) + 2)
+	one := initFrom(a)
+	one.SetInt64(1)
+	z = one.Sub(a.erfSeries())
+	z.SetPrecision(x.precision)
+	return z
+}
+
+// Return the number of digits lost to the factor e^(-x²) in erf and erfc. Its
+// argument has an absolute error proportional to x².
+func erfLoss(x *Real) int {
+	return max(2*(x.exponent+1), 0) + 2
+}
+
+// Returns true if erfc(x), for x > 0, should be computed with its continued
+// fraction, which converges quickly once x² is a sizable fraction of the
+// precision. Below that, the series for erf converges quickly instead.
+func (x *Real) erfcFraction() bool {
+	if x.exponent > 8 {
+		return true
+	}
+	f, _ := x.Float64()
+	return f*f > float64(x.precision)/4
+}
+
+// Return erf(x) for x > 0 using the series
+//
+//	erf(x) = 2x/√π · e^(-x²) · Σ (2x²)ⁿ/(1·3·5···(2n+1))
+//
+// whose terms are all positive, so nothing cancels. The terms grow until n is
+// about x², and the series is summed until they are negligible.
+func (x *Real) erfSeries() *Real {
+	x2 := x.mul(x)
+	t2 := x2.Add(x2)
+	t := initFrom(x)
+	t.SetInt64(1)
+	s := t
+	for n := uint64(1); ; n++ {
+		if n > MaxErfIterations {
+			panic(fmt.Sprintf("failed to converge erf(%v)", x))
+		}
+		t = t.mul(t2).divUint64(2*n + 1)
+		if t.exponent < s.exponent-int(x.precision)-1 {
+			break
+		}
+		s = s.Add(t)
+	}
+
+	two := initFrom(x)
+	two.SetInt64(2)
+	x2.negate()
+	return two.mul(x).mul(x2.exp()).mul(s).div(pi(x).sqrt())
+}
+
+// Return erfc(x) for x > 0 using the continued fraction
+//
+//	erfc(x) = e^(-x²)/√π · 1/(x + (1/2)/(x + 1/(x + (3/2)/(x + ...))))
+//
+// evaluated with the modified Lentz method.
+func (x *Real) erfcContinuedFraction() *Real {
+	one := initFrom(x)
+	one.SetInt64(1)
+	f := x
+	c := x
+	d := initFrom(x)
+	for n := uint64(1); ; n++ {
+		if n > MaxErfIterations {
+			panic(fmt.Sprintf("failed to converge erfc(%v)", x))
+		}
+		a := initFrom(x)
+		a.SetUint64(n)
+		a = a.divUint64(2)
+
+		// d = 1/(x + a·d), c = x + a/c
+		d = x.Add(a.mul(d)).reciprocal()
+		c = x.Add(a.div(c))
+		delta := c.mul(d)
+		f = f.mul(delta)
+		if r := delta.Sub(one); r.IsZero() || r.exponent < -int(x.precision)-1 {
+			break
+		}
+	}
+
+	x2 := x.mul(x)
+	x2.negate()
+	return x2.exp().div(pi(x).sqrt().mul(f))
+}
+
+// Return the inverse error function of x, the y for which erf(y) = x. The
+// result is correctly rounded. erfinv(±1) is ±Inf, and values outside [-1, 1]
+// are NaN.
+func (x *Real) ErfInv() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		z := x.working(w).erfInv()
+		return z, int(w) - internalPrecisionBuffer - 2
+	})
+}
+
+func (x *Real) erfInv() *Real {
+	z := initFrom(x)
+	a := x.Abs()
+	c := a.Compare(NewInt64(1))
+	if x.IsNaN() || c == 1 {
+		z.form = FormNaN
+		return z
+	} else if c == 0 {
+		z.form = FormInf
+		z.negative = x.negative
+		return z
+	} else if x.IsZero() {
+		return z
+	}
+
+	// Near ±1, erfinv(x) = erfcinv(1-|x|), where 1-|x| is exact and keeps
+	// the digits that x cannot.
+	if a.Compare(NewFloat64(0.5)) == 1 {
+		one := initFrom(x)
+		one.SetInt64(1)
+		a.negate()
+		q := addExact(one, a)
+		q.precision = x.precision
+		z = q.erfcInv()
+	} else {
+		f, _ := a.Float64()
+		z.SetFloat64(math.Erfinv(f))
+		z = a.erfHalley(z, false)
+	}
+	if x.negative {
+		z.negate()
+	}
+	return z
+}
+
+// Return the inverse complementary error function of x, the y for which
+// erfc(y) = x. The result is correctly rounded, including for x far into the
+// tail near 0. erfcinv(0) is +Inf, erfcinv(2) is -Inf, and values outside
+// [0, 2] are NaN.
+func (x *Real) ErfcInv() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		z := x.working(w).erfcInv()
+		return z, int(w) - internalPrecisionBuffer - 2
+	})
+}
+
+func (x *Real) erfcInv() *Real {
+	z := initFrom(x)
+	c := x.Compare(NewInt64(1))
+	if x.IsNaN() || x.negative || x.Compare(NewInt64(2)) == 1 {
+		z.form = FormNaN
+		return z
+	} else if x.IsZero() {
+		z.form = FormInf
+		return z
+	} else if c == 0 {
+		return z
+	} else if c == 1 {
+		// erfcinv(x) = -erfcinv(2-x)
+		two := initFrom(x)
+		two.SetInt64(2)
+		n := x.Copy()
+		n.negate()
+		q := addExact(two, n)
+		q.precision = x.precision
+		z = q.erfcInv()
+		z.negate()
+		return z
+	}
+
+	// Seed with a float64 estimate. math.Erfcinv computes 1-x, so for small
+	// x use the leading terms of the asymptotic expansion instead,
+	// y ≈ √(t - ln(πt)/2) with t = -ln(x), which also reaches below the
+	// range of float64.
+	if x.exponent > -10 {
+		f, _ := x.Float64()
+		z.SetFloat64(math.Erfcinv(f))
+	} else {
+		m := x.Copy()
+		m.exponent = 0
+		f, _ := m.Float64()
+		t := -math.Log(f) - float64(x.exponent)*math.Ln10
+		z.SetFloat64(math.Sqrt(t - math.Log(math.Pi*t)/2))
+	}
+	return x.erfHalley(z, true)
+}
+
+// Return y such that erf(y) = x, or erfc(y) = x if complement is set, refining
+// the estimate z with Halley's method. With f(y) = erf(y) - x, f” = -2y·f',
+// so each step is
+//
+//	y = y - Δ/(1 + yΔ), where Δ = f/f' = (erf(y) - x)·√π/2·e^(y²)
+func (x *Real) erfHalley(z *Real, complement bool) *Real {
+	half := initFrom(x)
+	half.SetInt64(5)
+	half.exponent = -1
+	one := initFrom(x)
+	one.SetInt64(1)
+	sp := pi(x).sqrt().mul(half)
+
+	for i := 0; ; i++ {
+		if i > MaxErfIterations {
+			panic(fmt.Sprintf("failed to converge erfinv(%v)", x))
+		}
+		var f *Real
+		if complement {
+			f = x.Sub(z.erfc())
+		} else {
+			f = z.erf().Sub(x)
+		}
+		delta := f.mul(sp).mul(z.mul(z).exp())
+		step := delta.div(one.Add(z.mul(delta)))
+		z = z.Sub(step)
+
+		// Convergence is cubic, so once the step is below half the
+		// precision, the remaining error is far below the last digit.
+		if step.IsZero() || step.exponent < z.exponent-int(x.precision)/2 {
+			return z
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestErf(t *testing.T) {
+	tests := []struct {
+		x, erf, erfc string
+	}{
+		{"0", "0", "1e0"},
+		{"1e-20", "1.128379167095512573896158903121545e-20", "9.999999999999999999887162083290449e-1"},
+		{"0.5", "5.204998778130465376827466538919645e-1", "4.795001221869534623172533461080355e-1"},
+		{"1", "8.427007929497148693412206350826093e-1", "1.572992070502851306587793649173907e-1"},
+		{"-2", "-9.953222650189527341620692563672529e-1", "1.995322265018952734162069256367253e0"},
+		{"3", "9.999779095030014145586272238704177e-1", "2.209049699858544137277612958232038e-5"},
+		{"5", "9.999999999984625402055719651498117e-1", "1.537459794428034850188343485383379e-12"},
+		{"6.5", "9.999999999999999999615785167287935e-1", "3.842148327120647469875804543768777e-20"},
+		{"10", "1e0", "2.088487583762544757000786294957789e-45"},
+		{"30", "1e0", "2.564656203756111600033397277501447e-393"},
+		{"100", "1e0", "6.405961424921732039021339148586394e-4346"},
+	}
+
+	for _, v := range tests {
+		x, err := ParseReal(v.x, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := x.Erf(); z.String() != v.erf {
+			t.Fatal("invalid erf", v.x, z)
+		}
+		if z := x.Erfc(); z.String() != v.erfc {
+			t.Fatal("invalid erfc", v.x, z)
+		}
+	}
+}
+
+// erf(15) is 1 to 98 digits, but is below 1, so it truncates to nines.
+func TestErfTruncated(t *testing.T) {
+	x, _ := ParseReal("15", 20)
+	x.SetMode(ModeZero)
+	if z := x.Erf(); z.String() != "9.9999999999999999999e-1" {
+		t.Fatal("invalid erf", z)
+	}
+}
+
+func TestErfHighPrecision(t *testing.T) {
+	x, err := ParseReal("30", 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	z := x.Erfc()
+
+	if fmt.Sprintf("%.50e", z) != "2.5646562037561116000333972775014471465488897227786e-393" {
+		t.Fatal("invalid erfc", fmt.Sprintf("%.50e", z))
+	}
+}
+
+func TestErfInv(t *testing.T) {
+	tests := []struct {
+		x, want string
+	}{
+		{"0", "0"},
+		{"1e-20", "8.862269254527580136490837416705726e-21"},
+		{"0.3", "2.724627147267543556219575985875658e-1"},
+		{"-0.9", "-1.163087153676674086726254260562948e0"},
+		{"0.999999", "3.45891073727950002215092763595757e0"},
+		{"0.9999999999999999999999999999999999", "8.691757058957823268592275662302692e0"},
+		{"1", "∞"},
+		{"-1", "-∞"},
+		{"1.5", "NaN"},
+	}
+
+	for _, v := range tests {
+		x, err := ParseReal(v.x, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := x.ErfInv(); z.String() != v.want {
+			t.Fatal("invalid erfinv", v.x, z)
+		}
+	}
+}
+
+func TestErfcInv(t *testing.T) {
+	tests := []struct {
+		x, want string
+	}{
+		{"0.5", "4.769362762044698733814183536431306e-1"},
+		{"1.5", "-4.769362762044698733814183536431306e-1"},
+		{"1", "0"},
+		{"1e-20", "6.601580622355142561516391632418707e0"},
+		{"1e-100", "1.50655747025926457044046105413689e1"},
+		{"1e-400", "3.028284244375871644515128082557054e1"},
+		{"0", "∞"},
+		{"2", "-∞"},
+		{"-1", "NaN"},
+	}
+
+	for _, v := range tests {
+		x, err := ParseReal(v.x, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := x.ErfcInv(); z.String() != v.want {
+			t.Fatal("invalid erfcinv", v.x, z)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+// MaxEvaluatePrecision is the largest working precision Evaluate will try
+// before giving up.
+var MaxEvaluatePrecision uint = 1 << 14
+
+// Evaluate computes f to the given number of correct decimal digits by
+// running it at increasing working precision until successive results agree
+// well enough to determine the rounding to digits (Ziv's strategy). f must
+// return an approximation of the same value computed with the given working
+// precision.
+//
+// The result is rounded to digits using the rounding mode of the value
+// returned by f. If the results have not stabilized before the working
+// precision exceeds MaxEvaluatePrecision, the most precise result is returned
+// and ok is false. This usually happens when the true value lies on or very
+// near a rounding boundary.
+func Evaluate(f func(p uint) *Real, digits uint) (z *Real, ok bool) {
+	if digits == 0 {
+		digits = DefaultPrecision
+	}
+	return ziv(f, digits, digits+internalPrecisionBuffer, MaxEvaluatePrecision)
+}
+
+// Evaluate f at increasing working precision, starting at w, until the result
+// can be correctly rounded to p digits or the working precision would exceed
+// maxw. The number of correct digits in each result is estimated by how many
+// leading digits it shares with the previous, less precise, result. The
+// returned value is rounded to p digits with the rounding mode of the result
+// of f, and ok is true if it is known to be correctly rounded.
+func ziv(f func(w uint) *Real, p, w, maxw uint) (z *Real, ok bool) {
+	round := func(x *Real) *Real {
+		z := x.Copy()
+		z.SetPrecision(p)
+		return z
+	}
+
+	prev := f(w)
+	prev.validate()
+	for {
+		w += umax(w/2, internalPrecisionBuffer)
+		if w > maxw {
+			return round(prev), false
+		}
+
+		cur := f(w)
+		cur.validate()
+
+		if cur.form != FormReal || prev.form != FormReal {
+			if stable(prev, cur) {
+				return round(cur), true
+			}
+			prev = cur
+			continue
+		}
+
+		d := cur.Sub(prev)
+		if d.IsZero() {
+			// Identical results that fit in the precision are exact.
+			if uint(cur.ndigits()) <= p || cur.roundable(p, w-internalPrecisionBuffer) {
+				return round(cur), true
+			}
+		} else if !cur.IsZero() && cur.exponent-d.exponent-1 > 0 {
+			if cur.roundable(p, uint(cur.exponent-d.exponent-1)) {
+				return round(cur), true
+			}
+		}
+		prev = cur
+	}
+}
+
+// Returns true if x and y represent the same value, including non-real forms.
+func stable(x, y *Real) bool {
+	if x.form != y.form {
+		return false
+	} else if x.IsNaN() {
+		return true
+	}
+	return x.Compare(y) == 0
+}
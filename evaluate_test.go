@@ -0,0 +1,38 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import "testing"
+
+func TestEvaluate(t *testing.T) {
+	// (1e20 + 2/3) - 1e20 loses 20 digits to cancellation
+	f := func(p uint) *Real {
+		big := NewInt64(1)
+		big.SetPrecision(p)
+		big.exponent = 20
+		two := NewInt64(2)
+		two.SetPrecision(p)
+		return big.Add(two.Div(NewInt64(3))).Sub(big)
+	}
+
+	z, ok := Evaluate(f, 30)
+	if !ok {
+		t.Fatal("failed to converge")
+	}
+	if z.String() != "6.66666666666666666666666666667e-1" {
+		t.Fatal("invalid evaluate", z)
+	}
+}
+
+func TestEvaluateUnstable(t *testing.T) {
+	f := func(p uint) *Real {
+		return NewUint64(uint64(p))
+	}
+
+	_, ok := Evaluate(f, 10)
+	if ok {
+		t.Fatal("unstable function converged")
+	}
+}
@@ -4,20 +4,23 @@
 
 package number
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+)
 
 // MaxExpIterations is the maximum number of iterations in the Taylor series
 // approximation of eˣ. If this limit is reached, Exp() will panic.
 const MaxExpIterations = 1000
 
-// Return the exponential of x (eˣ).
+// Return the exponential of x (eˣ). The result is correctly rounded.
 func (x *Real) Exp() *Real {
-	x.validate()
-	x2 := x.Copy()
-	x2.pip(x.precision)
-	z := x2.exp()
-	z.SetPrecision(x.precision)
-	return z
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		// eˣ has a condition number of |x|, so each digit left of the
+		// decimal point costs a digit of accuracy.
+		z := x.working(w).exp()
+		return z, int(w) - internalPrecisionBuffer - max(x.exponent+1, 0)
+	})
 }
 
 func (x *Real) exp() *Real {
@@ -71,13 +74,14 @@ func (x *Real) exp() *Real {
 	xscaled := x.Copy()
 	xscaled.exponent = 0
 
+	// each term is the previous one multiplied by x/i
+	q := initFrom(xscaled)
+	q.SetUint64(1)
 	var converged bool
 	for i := 0; i < MaxExpIterations; i++ {
-		n := xscaled.ipow(i)
-		d := initFrom(xscaled)
-		d.SetUint64(uint64(i))
-		d = d.Factorial()
-		q := n.div(d)
+		if i > 0 {
+			q = q.mul(xscaled).divUint64(uint64(i))
+		}
 		zn := z.Add(q)
 		if z.Compare(zn) == 0 {
 			z = zn
@@ -92,3 +96,120 @@ func (x *Real) exp() *Real {
 
 	return z
 }
+
+// Return 10 to the power of x (10ˣ). The result is correctly rounded, and
+// exact when x is an integer.
+func (x *Real) Exp10() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		return x.working(w).exp10(), int(w) - internalPrecisionBuffer - 1
+	})
+}
+
+func (x *Real) exp10() *Real {
+	if x.IsInf() || x.IsNaN() || x.IsZero() {
+		return x.exp()
+	}
+
+	// 10ˣ == 10ⁿ × e^(f×ln(10)) for x == n + f, and 10ⁿ is just an exponent.
+	n := x.Integer()
+	e, err := n.Int64()
+	if err != nil || e > math.MaxInt32 || e < math.MinInt32 {
+		// beyond any representable exponent
+		z := initFrom(x)
+		if !x.negative {
+			z.form = FormInf
+		}
+		return z
+	}
+
+	z := initFrom(x)
+	z.SetUint64(1)
+	f := x.Sub(n)
+	if !f.IsZero() {
+		z = f.mul(ln10(x)).exp()
+	}
+	z.exponent += int(e)
+	return z
+}
+
+// Return 2 to the power of x (2ˣ). The result is correctly rounded, and exact
+// when x is an integer and the result fits in the precision.
+func (x *Real) Exp2() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		return x.working(w).exp2(), int(w) - internalPrecisionBuffer - max(x.exponent+1, 0)
+	})
+}
+
+func (x *Real) exp2() *Real {
+	if x.IsInf() || x.IsNaN() || x.IsZero() {
+		return x.exp()
+	}
+
+	if x.IsInteger() {
+		k, err := x.Int64()
+		if err == nil && k <= math.MaxInt32 && k >= math.MinInt32 {
+			// 2⁻ᵏ == 5ᵏ×10⁻ᵏ, which avoids an inexact reciprocal
+			b := initFrom(x)
+			if k >= 0 {
+				b.SetUint64(2)
+				return b.ipow(int(k))
+			}
+			b.SetUint64(5)
+			z := b.ipow(int(-k))
+			z.exponent += int(k)
+			return z
+		}
+	}
+
+	return x.mul(ln2(x)).exp()
+}
+
+// Return eˣ-1. The result is correctly rounded, and unlike x.Exp().Sub(one),
+// stays accurate for x near zero.
+func (x *Real) Expm1() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		return x.working(w).expm1(), int(w) - internalPrecisionBuffer - max(x.exponent+1, 0)
+	})
+}
+
+func (x *Real) expm1() *Real {
+	if x.IsInf() && x.negative {
+		z := initFrom(x)
+		z.SetInt64(-1)
+		return z
+	} else if x.IsInf() || x.IsNaN() || x.IsZero() {
+		return x.Copy()
+	}
+
+	one := initFrom(x)
+	one.SetUint64(1)
+
+	// There's no cancellation for |x| >= 1.
+	if x.exponent >= 0 {
+		return x.exp().Sub(one)
+	}
+
+	// The Taylor series of eˣ without the leading 1, x + x²/2! + x³/3! + ...,
+	// where each term is computed from the last.
+	z := x.Copy()
+	term := x.Copy()
+	i := initFrom(x)
+	i.SetUint64(1)
+
+	var converged bool
+	for n := 0; n < MaxExpIterations; n++ {
+		i = i.Add(one)
+		term = term.mul(x).div(i)
+		zn := z.Add(term)
+		if z.Compare(zn) == 0 {
+			converged = true
+			break
+		}
+		z = zn
+	}
+	if !converged {
+		panic(fmt.Sprintf("failed to converge expm1(%v)", x))
+	}
+
+	return z
+}
@@ -4,7 +4,10 @@
 
 package number
 
-import "testing"
+import (
+	"fmt"
+	"testing"
+)
 
 func TestExp1(t *testing.T) {
 	x := NewUint64(5)
@@ -94,9 +97,131 @@ func TestExp0(t *testing.T) {
 
 func BenchmarkExp(b *testing.B) {
 	x := new(Real)
-	x.significand = []byte{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9}
+	x.setSignificandDigits([]byte{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9})
 	x.validate()
 	for b.Loop() {
 		x.Exp()
 	}
 }
+
+// Cases where the digits following the last are very close to a rounding
+// boundary.
+func TestExpHardRounding(t *testing.T) {
+	tests := []struct {
+		x    string
+		p    uint
+		mode int
+		want string
+	}{
+		// e^(1e-20) == 1.00000000000000000001|00000000000000000000500...
+		{"1e-20", 21, ModeZero, "1.00000000000000000001e0"},
+		// e^(-1e-20) == 9.9999999999999999999|000000000000000000005e-1...
+		{"-1e-20", 21, ModeZero, "9.9999999999999999999e-1"},
+		{"-1e-20", 21, ModeNearestEven, "9.9999999999999999999e-1"},
+		{"36.596", 40, ModeNearestEven, "7.824216511515269822655581246947682994638e15"},
+		{"36.596", 40, ModeZero, "7.824216511515269822655581246947682994637e15"},
+		{"68.086", 40, ModeNearestEven, "3.710001582513196978415033924225472379221e29"},
+		{"68.086", 40, ModeNearest, "3.710001582513196978415033924225472379221e29"},
+		{"104.202", 40, ModeNearestEven, "1.796195496956011700222079211144318427656e45"},
+	}
+
+	for _, tt := range tests {
+		x, _ := ParseReal(tt.x, tt.p)
+		x.SetMode(tt.mode)
+		z := x.Exp()
+		if s := fmt.Sprintf("%.*e", tt.p, z); s != tt.want {
+			t.Fatal("invalid exp", tt.x, tt.mode, s)
+		}
+	}
+}
+
+func TestExpBase10(t *testing.T) {
+	x := NewInt64(3)
+	z := x.Exp10()
+
+	if z.String() != "1e3" {
+		t.Fatal("invalid exp10", z)
+	}
+
+	x, _ = ParseReal("-2.5", DefaultPrecision)
+	z = x.Exp10()
+	if z.String() != "3.162277660168379331998893544432719e-3" {
+		t.Fatal("invalid exp10", z)
+	}
+
+	x, _ = ParseReal("1e30", DefaultPrecision)
+	z = x.Exp10()
+	if z.String() != "∞" {
+		t.Fatal("invalid exp10", z)
+	}
+}
+
+func TestExpBase2(t *testing.T) {
+	x := NewInt64(10)
+	z := x.Exp2()
+
+	if z.String() != "1.024e3" {
+		t.Fatal("invalid exp2", z)
+	}
+
+	z = NewInt64(-3).Exp2()
+	if z.String() != "1.25e-1" {
+		t.Fatal("invalid exp2", z)
+	}
+
+	x, _ = ParseReal("0.5", DefaultPrecision)
+	z = x.Exp2()
+	if z.String() != "1.414213562373095048801688724209698e0" {
+		t.Fatal("invalid exp2", z)
+	}
+}
+
+func TestExpm1(t *testing.T) {
+	tests := []struct {
+		x    string
+		want string
+	}{
+		{"1.234e-20", "1.23400000000000000000761378e-20"},
+		{"-1e-10", "-9.99999999950000000001666666666625e-11"},
+		{"0.5", "6.487212707001281468486507878141636e-1"},
+		{"-3", "-9.502129316321360570206575843499382e-1"},
+		{"1e-1000", "1e-1000"},
+	}
+
+	for _, tt := range tests {
+		x, _ := ParseReal(tt.x, DefaultPrecision)
+		z := x.Expm1()
+		if z.String() != tt.want {
+			t.Fatal("invalid expm1", tt.x, z)
+		}
+	}
+
+	// the naive approach loses everything
+	x, _ := ParseReal("1e-1000", DefaultPrecision)
+	if z := x.Exp().Sub(NewInt64(1)); !z.IsZero() {
+		t.Fatal("naive expm1 unexpectedly accurate", z)
+	}
+}
+
+// Near zero, the result is just above or below x, which decides the
+// truncated result.
+func TestExpm1Tiny(t *testing.T) {
+	tests := []struct {
+		x    string
+		mode int
+		want string
+	}{
+		{"1e-40", ModeZero, "1e-40"},
+		{"1e-40", ModeNearest, "1e-40"},
+		{"-1e-40", ModeZero, "-9.999999999999999999999999999999999e-41"},
+		{"-1e-40", ModeNearest, "-1e-40"},
+	}
+
+	for _, tt := range tests {
+		x, _ := ParseReal(tt.x, DefaultPrecision)
+		x.SetMode(tt.mode)
+		if z := x.Expm1(); z.String() != tt.want {
+			t.Fatal("invalid expm1", tt.x, tt.mode, z)
+		}
+	}
+}
@@ -4,37 +4,57 @@
 
 package number
 
-// Factorial returns the integer factorial of x. If x is not an integer, the
-// integer portion of x is used.
+// The largest integer whose factorial is computed by multiplying out the
+// product exactly. Larger factorials are computed from the gamma function.
+const maxExactFactorial = 1000
+
+// Factorial returns the factorial of x. The factorial of a non-integer is
+// Γ(x+1). The result is correctly rounded. The factorial of a negative integer
+// is NaN.
 func (x *Real) Factorial() *Real {
 	x.validate()
 
-	if x.IsInf() {
-		z := initFrom(x)
-		z.form = FormInf
-		return z
-	} else if x.IsNaN() {
-		z := initFrom(x)
-		z.form = FormNaN
-		return z
-	} else if x.negative {
+	if x.form != FormReal {
+		return x.Gamma()
+	} else if x.negative && x.IsInteger() {
 		z := initFrom(x)
 		z.form = FormNaN
 		return z
 	}
 
-	z := initFrom(x)
-	z.SetUint64(1)
-	if x.Compare(NewUint64(2)) == -1 {
+	if n, ok := x.smallInteger(); ok && n <= maxExactFactorial {
+		z := factorialExact(n)
+		z.mode = x.mode
+		z.SetPrecision(x.precision)
 		return z
 	}
 
-	i := initFrom(x)
-	i.SetUint64(2)
-	ipart := x.Integer()
-	for i.Compare(ipart) != 1 {
-		z = z.mul(i)
-		i = i.Add(NewUint64(1))
+	one := initFrom(x)
+	one.SetInt64(1)
+	y := addExact(x, one)
+	y.precision = x.precision
+	y.mode = x.mode
+	return y.Gamma()
+}
+
+// Return n! exactly, for n ≥ 0.
+func factorialExact(n int) *Real {
+	return productRange(2, uint64(n))
+}
+
+// Return the exact product of the integers in [a, b], or 1 if the range is
+// empty. The range is split in half recursively so that the operands of each
+// multiplication have similar lengths.
+func productRange(a, b uint64) *Real {
+	if a > b {
+		return NewUint64(1)
+	} else if b-a < 8 {
+		z := NewUint64(a)
+		for i := a + 1; i <= b; i++ {
+			z = mulExact(z, NewUint64(i))
+		}
+		return z
 	}
-	return z
+	m := (a + b) / 2
+	return mulExact(productRange(a, m), productRange(m+1, b))
 }
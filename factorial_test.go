@@ -69,3 +69,34 @@ func TestFactorialNegative(t *testing.T) {
 		t.Fatal("invalid factorial", z)
 	}
 }
+
+func TestFactorialNonInteger(t *testing.T) {
+	tests := []struct {
+		x, want string
+	}{
+		{"0.5", "8.862269254527580136490837416705726e-1"},
+		{"-0.5", "1.772453850905516027298167483341145e0"},
+		{"-12345.678", "-2.235449744171548812929964470720284e-45149"},
+		{"1e-40", "1e0"},
+	}
+
+	for _, v := range tests {
+		x, err := ParseReal(v.x, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := x.Factorial(); z.String() != v.want {
+			t.Fatal("invalid factorial", v.x, z)
+		}
+	}
+}
+
+func TestFactorialLarge(t *testing.T) {
+	// Beyond the exact product, the factorial comes from Γ(x+1).
+	x := NewUint64(1000000)
+	z := x.Factorial()
+
+	if z.String() != "8.263931688331240062376646103172666e5565708" {
+		t.Fatal("invalid factorial", z)
+	}
+}
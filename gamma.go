@@ -0,0 +1,310 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import "fmt"
+
+// MaxGammaIterations is the maximum number of terms in the asymptotic series
+// used for the gamma and digamma functions. If this limit is reached, the
+// function will panic.
+const MaxGammaIterations = 10000
+
+// Return the gamma function of x. The result is correctly rounded, and exact
+// for positive integers. Γ(0) is +Inf, and Γ is NaN at the negative integers
+// and -Inf.
+func (x *Real) Gamma() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		z, exact := x.working(w).gamma()
+		if exact {
+			return z, exactDigits
+		}
+		// Γ is the exponential of ln Γ, so the absolute error of the
+		// logarithm becomes the relative error of the result.
+		return z, int(w) - internalPrecisionBuffer - lgammaLoss(w, x)
+	})
+}
+
+func (x *Real) gamma() (*Real, bool) {
+	z := initFrom(x)
+	if x.IsNaN() || (x.IsInf() && x.negative) {
+		z.form = FormNaN
+		return z, true
+	} else if x.IsInf() || x.IsZero() {
+		z.form = FormInf
+		return z, true
+	} else if x.negative && x.IsInteger() {
+		z.form = FormNaN
+		return z, true
+	}
+
+	if n, ok := x.smallInteger(); ok && n <= maxExactFactorial+1 {
+		z = factorialExact(n - 1)
+		z.precision = x.precision
+		z.mode = x.mode
+		return z, true
+	}
+
+	if x.negative {
+		// Γ(x) = π / (sin(πx)·Γ(1-x))
+		g, _ := x.reflect().gamma()
+		s, _ := x.sincosPi()
+		return pi(x).div(s.mul(g)), false
+	}
+	return x.lgammaPositive().exp(), false
+}
+
+// Return the natural logarithm of the absolute value of Γ(x), and the sign of
+// Γ(x), either 1 or -1. The result is correctly rounded. At the poles of Γ the
+// result is +Inf.
+func (x *Real) LogGamma() (*Real, int) {
+	var sign int
+	z := x.correctlyRounded(func(w uint) (*Real, int) {
+		var z *Real
+		z, sign = x.working(w).lgamma()
+		// The error is absolute, so results near zero lose digits.
+		return z, int(w) - internalPrecisionBuffer - lgammaLoss(w, x) + min(z.exponent, 0)
+	})
+	return z, sign
+}
+
+func (x *Real) lgamma() (*Real, int) {
+	z := initFrom(x)
+	if x.IsNaN() {
+		z.form = FormNaN
+		return z, 1
+	} else if x.IsInf() {
+		z.form = FormInf
+		z.negative = x.negative
+		return z, 1
+	} else if x.IsZero() || (x.negative && x.IsInteger()) {
+		z.form = FormInf
+		return z, 1
+	} else if x.Compare(NewInt64(1)) == 0 || x.Compare(NewInt64(2)) == 0 {
+		return z, 1
+	}
+
+	if x.negative {
+		// ln|Γ(x)| = ln π - ln|sin(πx)| - ln Γ(1-x)
+		s, _ := x.sincosPi()
+		sign := 1
+		if s.negative {
+			sign = -1
+		}
+		s.negative = false
+		return pi(x).ln().Sub(s.ln()).Sub(x.reflect().lgammaPositive()), sign
+	}
+	return x.lgammaPositive(), 1
+}
+
+// Return the number of digits lost computing ln Γ(x) with working precision
+// w, from the magnitude of the logarithm and of the shift to Stirling's
+// series.
+func lgammaLoss(w uint, x *Real) int {
+	return digits(int(w)) + max(x.exponent+1, 0) + 2
+}
+
+// Return ln Γ(x) for x > 0. x is shifted up by the recurrence Γ(x+1) = xΓ(x)
+// until Stirling's series converges quickly:
+//
+//	ln Γ(y) = (y-½)ln y - y + ½ln 2π + Σ B₂ₖ/(2k(2k-1)y^(2k-1))
+func (x *Real) lgammaPositive() *Real {
+	y, p := x.shift()
+
+	half := initFrom(x)
+	half.SetInt64(5)
+	half.exponent = -1
+	two := initFrom(x)
+	two.SetInt64(2)
+
+	z := y.Sub(half).mul(y.ln()).Sub(y).Add(pi(x).mul(two).ln().mul(half))
+
+	r := y.reciprocal()
+	r2 := r.mul(r)
+	for k := 1; ; k++ {
+		if k > MaxGammaIterations {
+			panic(fmt.Sprintf("failed to converge lgamma(%v)", x))
+		}
+		t := bernoulli2k(k, x).mul(r).divUint64(uint64(2 * k * (2*k - 1)))
+		if t.IsZero() || t.exponent < z.exponent-int(x.precision)-1 {
+			break
+		}
+		z = z.Add(t)
+		r = r.mul(r2)
+	}
+
+	if p != nil {
+		z = z.Sub(p.ln())
+	}
+	return z
+}
+
+// Return y = x+n, for the smallest integer n ≥ 0 such that y is at least the
+// precision of x, along with the product x(x+1)...(x+n-1), or nil if n is
+// zero. The asymptotic series for Γ and ψ converge quickly at y.
+func (x *Real) shift() (y, p *Real) {
+	y = x
+	bound := NewUint64(uint64(x.precision))
+	one := initFrom(x)
+	one.SetInt64(1)
+	for y.Compare(bound) == -1 {
+		if p == nil {
+			p = y
+		} else {
+			p = p.mul(y)
+		}
+		y = y.Add(one)
+	}
+	return y, p
+}
+
+// Return 1-x, exactly, with the precision and rounding mode of x.
+func (x *Real) reflect() *Real {
+	one := initFrom(x)
+	one.SetInt64(1)
+	n := x.Copy()
+	n.negate()
+	y := addExact(one, n)
+	y.precision = x.precision
+	y.mode = x.mode
+	return y
+}
+
+// Return sin(πx) and cos(πx). The argument is reduced exactly, so the results
+// are accurate near the integers.
+func (x *Real) sincosPi() (s, c *Real) {
+	d := initFrom(x)
+	d.SetInt64(180)
+	t := x.working(umax(x.precision, uint(x.ndigits())) + 3).mul(d)
+	t.precision = x.precision
+	return t.sincosDeg()
+}
+
+// Return the digamma function ψ(x), the logarithmic derivative of Γ(x). The
+// result is correctly rounded. ψ is NaN at the poles of Γ and at -Inf.
+func (x *Real) Digamma() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		z := x.working(w).digamma()
+		return z, int(w) - internalPrecisionBuffer - digits(int(w)) - digits(x.exponent) - 2 + min(z.exponent, 0)
+	})
+}
+
+func (x *Real) digamma() *Real {
+	z := initFrom(x)
+	if x.IsNaN() || (x.IsInf() && x.negative) || x.IsZero() || (x.negative && x.IsInteger()) {
+		z.form = FormNaN
+		return z
+	} else if x.IsInf() {
+		z.form = FormInf
+		return z
+	}
+
+	if x.negative {
+		// ψ(x) = ψ(1-x) - π·cot(πx)
+		s, c := x.sincosPi()
+		return x.reflect().digamma().Sub(pi(x).mul(c).div(s))
+	}
+
+	// ψ(x) = ψ(x+n) - Σ 1/(x+k), for k in [0, n)
+	y, _ := x.shift()
+	one := initFrom(x)
+	one.SetInt64(1)
+	for v := x; v.Compare(y) == -1; v = v.Add(one) {
+		z = z.Sub(v.reciprocal())
+	}
+
+	// ψ(y) = ln y - 1/(2y) - Σ B₂ₖ/(2k·y^2k)
+	r := y.reciprocal()
+	z = z.Add(y.ln()).Sub(r.divUint64(2))
+	r2 := r.mul(r)
+	r = r2
+	for k := 1; ; k++ {
+		if k > MaxGammaIterations {
+			panic(fmt.Sprintf("failed to converge digamma(%v)", x))
+		}
+		t := bernoulli2k(k, x).mul(r).divUint64(uint64(2 * k))
+		if t.IsZero() || t.exponent < z.exponent-int(x.precision)-1 {
+			break
+		}
+		z = z.Sub(t)
+		r = r.mul(r2)
+	}
+	return z
+}
+
+// Return the beta function B(x, y) = Γ(x)Γ(y)/Γ(x+y). The result is correctly
+// rounded, and has the precision and rounding mode of x. B is NaN when x or y
+// is a pole of Γ, and zero when only x+y is.
+func (x *Real) Beta(y *Real) *Real {
+	y.validate()
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		z, exact := x.working(w).beta(y)
+		if exact {
+			return z, exactDigits
+		}
+		return z, int(w) - internalPrecisionBuffer - lgammaLoss(w, x.Abs().Max(y.Abs())) - 1
+	})
+}
+
+func (x *Real) beta(y *Real) (*Real, bool) {
+	z := initFrom(x)
+	if x.IsNaN() || y.IsNaN() {
+		z.form = FormNaN
+		return z, true
+	} else if x.IsInf() || y.IsInf() {
+		// B(+Inf, y) = 0 for y > 0
+		a, b := x, y
+		if b.IsInf() {
+			a, b = b, a
+		}
+		if a.negative || b.IsInf() || b.negative || b.IsZero() {
+			z.form = FormNaN
+		}
+		return z, true
+	} else if x.isGammaPole() || y.isGammaPole() {
+		z.form = FormNaN
+		return z, true
+	}
+
+	s := addExact(x, y)
+	s.precision = x.precision
+	s.mode = x.mode
+	if s.isGammaPole() {
+		return z, true
+	}
+
+	// For positive integers, B(x, y) = (x-1)!(y-1)!/(x+y-1)! is rational,
+	// and exact when the quotient terminates.
+	m, ok1 := x.smallInteger()
+	n, ok2 := y.smallInteger()
+	if ok1 && ok2 && m > 0 && n > 0 && m+n <= maxExactFactorial+1 {
+		num := mulExact(factorialExact(m-1), factorialExact(n-1))
+		den := factorialExact(m + n - 1)
+		n := num.Copy()
+		n.mode = x.mode
+		n.SetPrecision(x.precision)
+		d := den.Copy()
+		d.mode = x.mode
+		d.SetPrecision(x.precision)
+		q := n.div(d)
+		return q, mulExact(q, den).Compare(num) == 0
+	}
+
+	// B(x, y) = ±exp(ln|Γ(x)| + ln|Γ(y)| - ln|Γ(x+y)|)
+	yw := y.working(x.precision)
+	yw.mode = x.mode
+	lx, sx := x.lgamma()
+	ly, sy := yw.lgamma()
+	ls, ss := s.lgamma()
+	z = lx.Add(ly).Sub(ls).exp()
+	if sx*sy*ss < 0 {
+		z.negate()
+	}
+	return z, false
+}
+
+// Returns true if x is zero or a negative integer, where Γ(x) has a pole.
+func (x *Real) isGammaPole() bool {
+	return x.form == FormReal && (x.IsZero() || (x.negative && x.IsInteger()))
+}
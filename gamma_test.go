@@ -0,0 +1,154 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestGamma(t *testing.T) {
+	tests := []struct {
+		x, gamma, lgamma string
+		sign             int
+	}{
+		{"0.5", "1.772453850905516027298167483341145e0", "5.723649429247000870717136756765294e-1", 1},
+		{"5", "2.4e1", "3.178053830347945619646941601297055e0", 1},
+		{"1", "1e0", "0", 1},
+		{"2", "1e0", "0", 1},
+		{"3.7", "4.170651783796603165393602998617984e0", "1.42807232666538792187238112504755e0", 1},
+		{"171", "7.257415615307998967396728211129263e306", "7.065730622457873471107222627212983e2", 1},
+		{"12345.678", "1.65788378022864559102981405773176e45149", "1.039599199055460609210805704936834e5", 1},
+		{"1e-10", "9.99999999942278433519737273891721e9", "2.302585092988273527369798593111683e1", 1},
+		{"0.999999999999", "1.000000000000577215664902521916602e0", "5.77215664902355327639936603986273e-13", 1},
+		{"-0.5", "-3.54490770181103205459633496668229e0", "1.265512123484645396488945797134706e0", -1},
+		{"-7.3", "4.183878730135476989817035273431442e-4", "-7.779101629826852441788273997795701e0", 1},
+		{"-100.5", "-3.353690819807678642208099692714592e-159", "-3.649009683094273518227565704629958e2", -1},
+		{"-12345.678", "1.810714441257538721591446391782034e-45153", "-1.039680309824738212413043903587758e5", 1},
+		{"-1e-5", "-1.000005772255555522350296780615573e5", "1.151293123720912453944931487572077e1", -1},
+	}
+
+	for _, v := range tests {
+		x, err := ParseReal(v.x, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := x.Gamma(); z.String() != v.gamma {
+			t.Fatal("invalid gamma", v.x, z)
+		}
+		if z, sign := x.LogGamma(); z.String() != v.lgamma || sign != v.sign {
+			t.Fatal("invalid log gamma", v.x, z, sign)
+		}
+	}
+}
+
+func TestGammaPoles(t *testing.T) {
+	inf := new(Real)
+	inf.form = FormInf
+	ninf := inf.Copy()
+	ninf.negative = true
+
+	tests := []struct {
+		x             *Real
+		gamma, lgamma string
+	}{
+		{NewInt64(0), "∞", "∞"},
+		{NewInt64(-1), "NaN", "∞"},
+		{NewInt64(-3), "NaN", "∞"},
+		{inf, "∞", "∞"},
+		{ninf, "NaN", "-∞"},
+	}
+
+	for _, v := range tests {
+		if z := v.x.Gamma(); z.String() != v.gamma {
+			t.Fatal("invalid gamma", v.x, z)
+		}
+		if z, _ := v.x.LogGamma(); z.String() != v.lgamma {
+			t.Fatal("invalid log gamma", v.x, z)
+		}
+	}
+}
+
+func TestGammaHighPrecision(t *testing.T) {
+	x, err := ParseReal("0.5", 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	z := x.Gamma()
+
+	if fmt.Sprintf("%.100e", z) != "1.772453850905516027298167483341145182797549456122387128213807789852911284591032181374950656738544665e0" {
+		t.Fatal("invalid gamma", fmt.Sprintf("%.100e", z))
+	}
+}
+
+func TestGammaModeZero(t *testing.T) {
+	x, err := ParseReal("3.7", DefaultPrecision)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x.SetMode(ModeZero)
+	z := x.Gamma()
+
+	if z.String() != "4.170651783796603165393602998617983e0" {
+		t.Fatal("invalid gamma", z)
+	}
+}
+
+func TestDigamma(t *testing.T) {
+	tests := []struct {
+		x, want string
+	}{
+		{"1", "-5.772156649015328606065120900824024e-1"},
+		{"0.5", "-1.963510026021423479440976332998756e0"},
+		{"1.4616321449683623", "-3.99287304124630439922999163661319e-17"},
+		{"100.25", "4.60267124327471255907687550162278e0"},
+		{"1e-10", "-1.000000000057721566473703945393371e10"},
+		{"-2.5", "1.103156640645243187225690333667911e0"},
+		{"-12345.678", "7.454965900115013519181264070304621e0"},
+		{"0", "NaN"},
+		{"-4", "NaN"},
+	}
+
+	for _, v := range tests {
+		x, err := ParseReal(v.x, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := x.Digamma(); z.String() != v.want {
+			t.Fatal("invalid digamma", v.x, z)
+		}
+	}
+}
+
+func TestBeta(t *testing.T) {
+	tests := []struct {
+		x, y, want string
+	}{
+		{"2", "3", "8.333333333333333333333333333333333e-2"},
+		{"4", "4", "7.142857142857142857142857142857143e-3"},
+		{"1", "1", "1e0"},
+		{"0.5", "0.5", "3.141592653589793238462643383279503e0"},
+		{"2.5", "-1.5", "3.141592653589793238462643383279503e0"},
+		{"-0.5", "-0.7", "3.123046688888346556896146965481154e0"},
+		{"1e-3", "7", "9.975537422749623866450286719138012e2"},
+		{"1000", "1000", "9.764902039697782546021617392263909e-604"},
+		{"-2", "3", "NaN"},
+		{"-2.5", "2.5", "0"},
+	}
+
+	for _, v := range tests {
+		x, err := ParseReal(v.x, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		y, err := ParseReal(v.y, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := x.Beta(y); z.String() != v.want {
+			t.Fatal("invalid beta", v.x, v.y, z)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+// Return the hyperbolic sine of x. The result is correctly rounded.
+func (x *Real) Sinh() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		return x.working(w).sinh(), int(w) - internalPrecisionBuffer - max(x.exponent+1, 0)
+	})
+}
+
+func (x *Real) sinh() *Real {
+	if x.IsNaN() || x.IsInf() || x.IsZero() {
+		return x.Copy()
+	}
+
+	// sinh(x) == (E + E/(E+1))/2 where E == eˣ-1, which doesn't cancel
+	// near zero like (eˣ - e⁻ˣ)/2 does.
+	xa := x.Abs()
+	e := xa.expm1()
+	one := initFrom(x)
+	one.SetUint64(1)
+	z := e.Add(e.div(e.Add(one))).div(NewUint64(2))
+	z.negative = x.negative
+	return z
+}
+
+// Return the hyperbolic cosine of x. The result is correctly rounded.
+func (x *Real) Cosh() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		return x.working(w).cosh(), int(w) - internalPrecisionBuffer - max(x.exponent+1, 0)
+	})
+}
+
+func (x *Real) cosh() *Real {
+	if x.IsNaN() {
+		return x.Copy()
+	} else if x.IsInf() {
+		return x.Abs()
+	}
+
+	// cosh(x) == (eˣ + e⁻ˣ)/2, where both terms are positive
+	e := x.Abs().exp()
+	return e.Add(e.reciprocal()).div(NewUint64(2))
+}
+
+// Return the hyperbolic tangent of x. The result is correctly rounded.
+func (x *Real) Tanh() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		return x.working(w).tanh(), int(w) - internalPrecisionBuffer
+	})
+}
+
+func (x *Real) tanh() *Real {
+	if x.IsNaN() || x.IsZero() {
+		return x.Copy()
+	} else if x.IsInf() {
+		z := initFrom(x)
+		z.SetInt64(1)
+		z.negative = x.negative
+		return z
+	}
+
+	// tanh(x) == E/(E+2) where E == e²ˣ-1
+	e := x.Abs().mul(NewUint64(2)).expm1()
+	z := e.div(e.Add(NewUint64(2)))
+	z.negative = x.negative
+	return z
+}
+
+// Return the inverse hyperbolic sine of x. The result is correctly rounded.
+func (x *Real) Asinh() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		return x.working(w).asinh(), int(w) - internalPrecisionBuffer
+	})
+}
+
+func (x *Real) asinh() *Real {
+	if x.IsNaN() || x.IsInf() || x.IsZero() {
+		return x.Copy()
+	}
+
+	// asinh(x) == ln(x + sqrt(x²+1)) == log1p(x + x²/(1+sqrt(1+x²))),
+	// which stays accurate near zero
+	one := initFrom(x)
+	one.SetUint64(1)
+	xa := x.Abs()
+	x2 := xa.mul(xa)
+	z := xa.Add(x2.div(one.Add(one.Add(x2).sqrt()))).log1p()
+	z.negative = x.negative
+	return z
+}
+
+// Return the inverse hyperbolic cosine of x. The result is correctly rounded.
+// The result is NaN if x < 1.
+func (x *Real) Acosh() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		return x.working(w).acosh(), int(w) - internalPrecisionBuffer
+	})
+}
+
+func (x *Real) acosh() *Real {
+	one := initFrom(x)
+	one.SetUint64(1)
+
+	if x.IsNaN() || x.Compare(one) == -1 {
+		z := initFrom(x)
+		z.form = FormNaN
+		return z
+	} else if x.IsInf() {
+		return x.Copy()
+	}
+
+	// acosh(x) == log1p(t + sqrt(2t + t²)) where t == x-1, which stays
+	// accurate near 1
+	t := x.Sub(one)
+	return t.Add(t.mul(NewUint64(2)).Add(t.mul(t)).sqrt()).log1p()
+}
+
+// Return the inverse hyperbolic tangent of x. The result is correctly
+// rounded. The result is NaN if |x| > 1 and ±∞ if |x| == 1.
+func (x *Real) Atanh() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		return x.working(w).atanh(), int(w) - internalPrecisionBuffer
+	})
+}
+
+func (x *Real) atanh() *Real {
+	one := initFrom(x)
+	one.SetUint64(1)
+
+	z := initFrom(x)
+	if x.IsNaN() || x.IsInf() || x.Abs().Compare(one) == 1 {
+		z.form = FormNaN
+		return z
+	} else if x.IsZero() {
+		return z
+	} else if x.Abs().Compare(one) == 0 {
+		z.form = FormInf
+		z.negative = x.negative
+		return z
+	}
+
+	// atanh(x) == log1p(2x/(1-x))/2
+	xa := x.Abs()
+	z = xa.mul(NewUint64(2)).div(one.Sub(xa)).log1p().div(NewUint64(2))
+	z.negative = x.negative
+	return z
+}
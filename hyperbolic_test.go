@@ -0,0 +1,187 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import "testing"
+
+func TestSinh(t *testing.T) {
+	tests := []struct {
+		x    string
+		want string
+	}{
+		{"1", "1.175201193643801456882381850595601e0"},
+		{"1e-20", "1e-20"},
+		{"-0.5", "-5.210953054937473616224256264114916e-1"},
+		{"20", "2.425825977048951379539766040514914e8"},
+		{"1.2345678901234567890123456789e-20", "1.2345678901234567890123456789e-20"},
+		{"inf", "∞"},
+		{"-inf", "-∞"},
+		{"nan", "NaN"},
+	}
+
+	for _, tt := range tests {
+		x, _ := ParseReal(tt.x, DefaultPrecision)
+		z := x.Sinh()
+		if z.String() != tt.want {
+			t.Fatal("invalid sinh", tt.x, z)
+		}
+	}
+}
+
+func TestCosh(t *testing.T) {
+	tests := []struct {
+		x    string
+		want string
+	}{
+		{"1", "1.543080634815243778477905620757062e0"},
+		{"1e-20", "1e0"},
+		{"-0.5", "1.127625965206380785226225161402672e0"},
+		{"-inf", "∞"},
+		{"0", "1e0"},
+	}
+
+	for _, tt := range tests {
+		x, _ := ParseReal(tt.x, DefaultPrecision)
+		z := x.Cosh()
+		if z.String() != tt.want {
+			t.Fatal("invalid cosh", tt.x, z)
+		}
+	}
+}
+
+func TestTanh(t *testing.T) {
+	tests := []struct {
+		x    string
+		want string
+	}{
+		{"1", "7.615941559557648881194582826047936e-1"},
+		{"1e-20", "1e-20"},
+		{"-0.5", "-4.621171572600097585023184836436725e-1"},
+		{"30", "9.999999999999999999999999824869785e-1"},
+		{"1.2345678901234567890123456789e-20", "1.2345678901234567890123456789e-20"},
+		{"-inf", "-1e0"},
+		{"inf", "1e0"},
+	}
+
+	for _, tt := range tests {
+		x, _ := ParseReal(tt.x, DefaultPrecision)
+		z := x.Tanh()
+		if z.String() != tt.want {
+			t.Fatal("invalid tanh", tt.x, z)
+		}
+	}
+}
+
+func TestAsinh(t *testing.T) {
+	tests := []struct {
+		x    string
+		want string
+	}{
+		{"1", "8.813735870195430252326093249797923e-1"},
+		{"1e-20", "1e-20"},
+		{"-0.5", "-4.812118250596034474977589134243684e-1"},
+		{"1e10", "2.371899811050040214959964666830182e1"},
+		{"1.2345678901234567890123456789e-20", "1.2345678901234567890123456789e-20"},
+		{"-inf", "-∞"},
+		{"0", "0"},
+	}
+
+	for _, tt := range tests {
+		x, _ := ParseReal(tt.x, DefaultPrecision)
+		z := x.Asinh()
+		if z.String() != tt.want {
+			t.Fatal("invalid asinh", tt.x, z)
+		}
+	}
+}
+
+func TestAcosh(t *testing.T) {
+	tests := []struct {
+		x    string
+		want string
+	}{
+		{"1.5", "9.624236501192068949955178268487368e-1"},
+		{"1.0000000001", "1.414213562361309935782178097179288e-5"},
+		{"10", "2.993222846126380897912667713774183e0"},
+		{"1", "0"},
+		{"0.5", "NaN"},
+		{"inf", "∞"},
+	}
+
+	for _, tt := range tests {
+		x, _ := ParseReal(tt.x, DefaultPrecision)
+		z := x.Acosh()
+		if z.String() != tt.want {
+			t.Fatal("invalid acosh", tt.x, z)
+		}
+	}
+}
+
+func TestAtanh(t *testing.T) {
+	tests := []struct {
+		x    string
+		want string
+	}{
+		{"0.5", "5.493061443340548456976226184612629e-1"},
+		{"1e-20", "1e-20"},
+		{"-0.9999999999", "-1.185949905522520107479794833415089e1"},
+		{"1.2345678901234567890123456789e-20", "1.2345678901234567890123456789e-20"},
+		{"1", "∞"},
+		{"-1", "-∞"},
+		{"1.5", "NaN"},
+	}
+
+	for _, tt := range tests {
+		x, _ := ParseReal(tt.x, DefaultPrecision)
+		z := x.Atanh()
+		if z.String() != tt.want {
+			t.Fatal("invalid atanh", tt.x, z)
+		}
+	}
+}
+
+// Near zero, tanh(x) is just below |x|, so it truncates to nines.
+func TestTanhTiny(t *testing.T) {
+	tests := []struct {
+		x    string
+		mode int
+		want string
+	}{
+		{"1e-40", ModeZero, "9.999999999999999999999999999999999e-41"},
+		{"1e-40", ModeNearest, "1e-40"},
+		{"-1e-40", ModeZero, "-9.999999999999999999999999999999999e-41"},
+		{"-1e-40", ModeNearest, "-1e-40"},
+	}
+
+	for _, tt := range tests {
+		x, _ := ParseReal(tt.x, DefaultPrecision)
+		x.SetMode(tt.mode)
+		if z := x.Tanh(); z.String() != tt.want {
+			t.Fatal("invalid tanh", tt.x, tt.mode, z)
+		}
+	}
+}
+
+// Near zero, asinh(x) is just below |x|, so it truncates to nines.
+func TestAsinhTiny(t *testing.T) {
+	tests := []struct {
+		x    string
+		mode int
+		want string
+	}{
+		{"1e-40", ModeZero, "9.999999999999999999999999999999999e-41"},
+		{"1e-40", ModeNearest, "1e-40"},
+		{"-1e-40", ModeZero, "-9.999999999999999999999999999999999e-41"},
+		{"-1e-40", ModeNearest, "-1e-40"},
+	}
+
+	for _, tt := range tests {
+		x, _ := ParseReal(tt.x, DefaultPrecision)
+		x.SetMode(tt.mode)
+		if z := x.Asinh(); z.String() != tt.want {
+			t.Fatal("invalid asinh", tt.x, tt.mode, z)
+		}
+	}
+}
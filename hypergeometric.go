@@ -0,0 +1,385 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import (
+	"fmt"
+	"math"
+)
+
+// MaxHypergeometricIterations is the maximum number of terms in the series
+// used for the generalized hypergeometric function. If this limit is reached,
+// the function will panic.
+const MaxHypergeometricIterations = 100000
+
+// Return the generalized hypergeometric function
+//
+//	ₚFₑ(a; b; z) = Σ (a₁)ₖ···(aₚ)ₖ / ((b₁)ₖ···(bₑ)ₖ) · z^k/k!, for k ≥ 0
+//
+// where (a)ₖ = a(a+1)···(a+k-1) is the rising factorial, p = len(a), and
+// q = len(b). The result is correctly rounded, and has the precision and
+// rounding mode of z. The working precision is raised to cover the digits
+// lost to cancellation in the series.
+//
+// The series is a polynomial when some aᵢ is zero or a negative integer, and
+// otherwise converges for all z when p ≤ q, and for |z| < 1 when p = q+1.
+// Beyond that, the closed forms ₀F₀(;;z) = e^z and ₁F₀(a;;z) = (1-z)^-a are
+// used, and ₂F₁ is continued to z < -1 and evaluated at z = 1 by Gauss's
+// theorem. Everywhere else the result is NaN, as it is for infinite z and when
+// some bⱼ is zero or a negative integer that the series reaches.
+//
+// Near z = 1, where the series for ₂F₁ converges slowly, the function is
+// continued from z = 1/2 instead. The result is also NaN when p = q+1 > 2 and
+// |z| is so close to 1 that the series would need more than
+// MaxHypergeometricIterations terms, or when ₂F₁ would need more than that
+// many steps of the continuation.
+func Hypergeometric(a, b []*Real, z *Real) *Real {
+	for _, v := range a {
+		v.validate()
+	}
+	for _, v := range b {
+		v.validate()
+	}
+	return z.correctlyRounded(func(w uint) (*Real, int) {
+		return z.working(w).hypergeometric(a, b)
+	})
+}
+
+func (z *Real) hypergeometric(a, b []*Real) (*Real, int) {
+	f := initFrom(z)
+	if z.form != FormReal {
+		f.form = FormNaN
+		return f, exactDigits
+	}
+	for _, params := range [][]*Real{a, b} {
+		for _, v := range params {
+			if v.form != FormReal {
+				f.form = FormNaN
+				return f, exactDigits
+			}
+		}
+	}
+
+	// the series ends after the term for k = n when some aᵢ = -n
+	n, polynomial := -1, false
+	for _, v := range a {
+		if m, ok := v.smallInteger(); ok && m <= 0 && (!polynomial || -m < n) {
+			n, polynomial = -m, true
+		}
+	}
+	for _, v := range b {
+		if m, ok := v.smallInteger(); ok && m <= 0 && (!polynomial || n > -m) {
+			// (bⱼ)ₖ is zero for k > -bⱼ
+			f.form = FormNaN
+			return f, exactDigits
+		}
+	}
+	if z.IsZero() {
+		f.SetInt64(1)
+		return f, exactDigits
+	} else if polynomial {
+		return z.hypergeometricSeries(a, b)
+	}
+
+	one := initFrom(z)
+	one.SetInt64(1)
+	r := int(z.precision) - 2*internalPrecisionBuffer
+	switch p, q := len(a), len(b); {
+	case p == 0 && q == 0:
+		return z.exp(), r
+	case p == 1 && q == 0:
+		// (1-z)^-a, which is +Inf at z = 1 for a > 0
+		if z.Compare(one) == 0 {
+			if !a[0].negative {
+				f.form = FormInf
+			}
+			return f, exactDigits
+		}
+		e := a[0].working(z.precision)
+		e.negate()
+		return one.Sub(z).pow(e), r - max(e.exponent+1, 0)
+	case p == 1 && q == 1 && z.negative:
+		// Kummer's transformation, ₁F₁(a; b; z) = e^z·₁F₁(b-a; b; -z),
+		// turns the alternating series into one of positive terms.
+		w := z.Copy()
+		w.negate()
+		f, s := w.hypergeometricSeries([]*Real{subExact(b[0], a[0])}, b)
+		return z.exp().mul(f), min(s, r)
+	case p == 2 && q == 1:
+		return z.hypergeometric2F1(a[0], a[1], b[0])
+	case p > q+1 || (p == q+1 && z.Abs().Compare(one) >= 0):
+		f.form = FormNaN
+		return f, exactDigits
+	case p == q+1:
+		// Near |z| = 1 the terms shrink by about |z| each, too slowly
+		// to sum within the iteration limit.
+		m, _ := z.Abs().Float64()
+		if float64(z.precision)*math.Ln10/-math.Log(m) > MaxHypergeometricIterations {
+			f.form = FormNaN
+			return f, exactDigits
+		}
+	}
+	return z.hypergeometricSeries(a, b)
+}
+
+// Return ₂F₁(a, b; c; z) for a non-polynomial series.
+func (z *Real) hypergeometric2F1(a, b, c *Real) (*Real, int) {
+	f := initFrom(z)
+	one := initFrom(z)
+	one.SetInt64(1)
+	half := initFrom(z)
+	half.SetInt64(-5)
+	half.exponent = -1
+	r := int(z.precision) - 2*internalPrecisionBuffer
+
+	switch {
+	case z.Compare(one) == 0:
+		// Gauss's theorem, ₂F₁(a, b; c; 1) = Γ(c)Γ(c-a-b) / (Γ(c-a)Γ(c-b)),
+		// when c-a-b > 0. Otherwise the series diverges.
+		s := subExact(subExact(c, a), b)
+		if s.negative || s.IsZero() {
+			f.form = FormNaN
+			return f, exactDigits
+		}
+		ca, cb := subExact(c, a), subExact(c, b)
+		for _, v := range []*Real{ca, cb} {
+			if m, ok := v.smallInteger(); ok && m <= 0 {
+				// 1/Γ is zero at the poles of Γ
+				return f, exactDigits
+			}
+		}
+		g := initFrom(z)
+		g.SetInt64(1)
+		for i, v := range []*Real{c, s, ca, cb} {
+			v = v.working(z.precision)
+			h, _ := v.gamma()
+			if i < 2 {
+				g = g.mul(h)
+			} else {
+				g = g.div(h)
+			}
+			r = min(r, int(z.precision)-internalPrecisionBuffer-lgammaLoss(z.precision, v)-2)
+		}
+		return g, r
+	case z.Compare(one) == 1:
+		f.form = FormNaN
+		return f, exactDigits
+	case z.Compare(half) == -1:
+		// Pfaff's transformation, ₂F₁(a, b; c; z) =
+		// (1-z)^-a·₂F₁(a, c-b; c; z/(z-1)), maps z < -1/2 into (1/3, 1),
+		// where 1 - z/(z-1) = 1/(1-z).
+		y := one.Sub(z)
+		e := a.working(z.precision)
+		e.negate()
+		f, s := y.reciprocal().hypergeometric2F1Below1(a, subExact(c, b), c)
+		if f.form == FormNaN {
+			return f, s
+		}
+		return y.powReal(e).mul(f), min(s, r-max(e.exponent+1, 0))
+	case z.Compare(half) == 1:
+		return one.Sub(z).hypergeometric2F1Below1(a, b, c)
+	}
+	return z.hypergeometricSeries([]*Real{a, b}, []*Real{c})
+}
+
+// Return ₂F₁(a, b; c; 1-u) for 0 < u < 2/3, along with the number of correct
+// digits. Within 1/2 of 0, the series converges quickly. Closer to 1, where it
+// converges slowly, the function is continued from z = 1/2 by re-expanding it
+// in Taylor series, each of which steps halfway to the singularity at 1.
+//
+// With z = 1-u and F(z + h) = Σ tₙhⁿ, the hypergeometric equation
+//
+//	z(1-z)F'' + (c - (a+b+1)z)F' - abF = 0
+//
+// gives the recurrence
+//
+//	z(1-z)(n+2)(n+1)tₙ₊₂ = -((1-2z)n + c-(a+b+1)z)(n+1)tₙ₊₁ + (n+a)(n+b)tₙ
+//
+// whose coefficients are computed from u, so that they stay exact near 1.
+// F or F' may be zero along the way, so the error is tracked as the exponent
+// of its bound rather than in digits relative to F.
+func (u *Real) hypergeometric2F1Below1(a, b, c *Real) (*Real, int) {
+	one := initFrom(u)
+	one.SetInt64(1)
+	half := initFrom(u)
+	half.SetInt64(5)
+	half.exponent = -1
+	if u.Compare(half) >= 0 {
+		return one.Sub(u).hypergeometricSeries([]*Real{a, b}, []*Real{c})
+	}
+	if float64(-u.exponent)*math.Log2(10) > MaxHypergeometricIterations {
+		// too many steps to reach u
+		f := initFrom(u)
+		f.form = FormNaN
+		return f, exactDigits
+	}
+
+	// F(1/2) and F'(1/2) = ab/c·₂F₁(a+1, b+1; c+1; 1/2)
+	p := int(u.precision)
+	aw, bw := a.working(u.precision), b.working(u.precision)
+	g := aw.mul(bw).div(c.working(u.precision))
+	f, lf := half.hypergeometricSum([]*Real{a, b}, []*Real{c})
+	d, ld := half.hypergeometricSum([]*Real{addExact(a, one), addExact(b, one)}, []*Real{addExact(c, one)})
+	err := max(f.exponent+lf, d.exponent+ld+g.exponent+1) - p
+	d = d.mul(g)
+
+	s1 := addExact(addExact(a, b), one).working(u.precision)              // a+b+1
+	s0 := subExact(subExact(subExact(c, a), b), one).working(u.precision) // c-a-b-1
+	var largest float64                                                   // the largest |a| or |b|
+	for _, v := range []*Real{a, b} {
+		g, _ := v.Abs().Float64()
+		largest = max(largest, g)
+	}
+
+	for u0 := half; u0.Compare(u) == 1; {
+		u1 := u0.divUint64(2).Max(u)
+		h := u0.Sub(u1)
+
+		z0 := one.Sub(u0)
+		q := z0.mul(u0)              // z(1-z)
+		w := u0.Add(u0).Sub(one)     // 1-2z
+		e := s0.Add(s1.mul(u0))      // c-(a+b+1)z
+		t0, t1 := f, d.mul(h)        // tₙhⁿ and tₙ₊₁hⁿ⁺¹
+		f, d = t0.Add(t1), t1.Copy() // F(z+h) and h·F'(z+h)
+
+		// the errors in F and h·F' grow as the largest term does
+		in := max(exponentOf(t0), exponentOf(t1))
+		top := in
+		k := initFrom(u)
+		n := 0
+		for ; ; n++ {
+			if n > MaxHypergeometricIterations {
+				panic(fmt.Sprintf("failed to converge hypergeometric(%v, %v, %v, %v)", a, b, c, u))
+			}
+			k.SetInt64(int64(n))
+			x := w.mul(k).Add(e).mul(k.AddInt64(1)).mul(t1).mul(h)
+			y := k.Add(aw).mul(k.Add(bw)).mul(t0).mul(h).mul(h)
+			t2 := y.Sub(x).div(q.mul(k.AddInt64(2)).mul(k.AddInt64(1)))
+			t0, t1 = t1, t2
+			f = f.Add(t2)
+			nt := t2.MulInt64(int64(n + 2))
+			d = d.Add(nt)
+			top = max(top, exponentOf(t2), exponentOf(nt))
+
+			// The terms shrink by about half, so once two in a row are
+			// negligible, so is the rest.
+			tiny := max(top-p, err) - 2
+			if float64(n) > largest && (t0.IsZero() || t0.exponent < tiny) && (t1.IsZero() || nt.exponent < tiny) {
+				break
+			}
+		}
+		err = max(err+top-max(in, err), top-p+digits(n)) + 1
+
+		// the next step needs F'(z+h), from h·F'(z+h)
+		d = d.div(h)
+		u0 = u1
+	}
+	if f.IsZero() {
+		return f, 0
+	}
+	return f, f.exponent - err - 2
+}
+
+// Return the exponent of x, or a very small one for zero.
+func exponentOf(x *Real) int {
+	if x.IsZero() {
+		return math.MinInt32
+	}
+	return x.exponent
+}
+
+// Return the exact difference x - y.
+func subExact(x, y *Real) *Real {
+	n := y.Copy()
+	n.negate()
+	return addExact(x, n)
+}
+
+// Return the sum of the hypergeometric series, along with the number of
+// correct digits. When the terms grow far beyond the sum before cancelling,
+// the series is summed again with that many more digits.
+func (z *Real) hypergeometricSeries(a, b []*Real) (*Real, int) {
+	p := z.precision
+	for {
+		f, loss := z.working(p).hypergeometricSum(a, b)
+		if f.IsZero() {
+			return f, 0
+		} else if loss <= int(p-z.precision)+internalPrecisionBuffer {
+			return f, int(p) - internalPrecisionBuffer - 2 - loss
+		}
+
+		// When the sum is lost entirely, the estimate of the loss is
+		// only a lower bound, so the precision also grows by half.
+		p = umax(z.precision+uint(loss), p+p/2)
+	}
+}
+
+// Return the sum of the hypergeometric series at the precision of z, and the
+// number of digits lost to cancellation and rounding. Each term follows from
+// the last by the ratio
+//
+//	tₖ₊₁/tₖ = (a₁+k)···(aₚ+k) / ((b₁+k)···(bₑ+k)) · z/(k+1)
+//
+// The series is summed until the terms are negligible and the ratio is small
+// enough to bound the rest of the sum.
+func (z *Real) hypergeometricSum(a, b []*Real) (*Real, int) {
+	aw := make([]*Real, len(a))
+	bw := make([]*Real, len(b))
+	var largest float64 // the largest |aᵢ| or |bⱼ|
+	for i, v := range a {
+		aw[i] = v.working(z.precision)
+		f, _ := v.Abs().Float64()
+		largest = max(largest, f)
+	}
+	for i, v := range b {
+		bw[i] = v.working(z.precision)
+		f, _ := v.Abs().Float64()
+		largest = max(largest, f)
+	}
+
+	one := initFrom(z)
+	one.SetInt64(1)
+	// For p = q+1, the ratio tends to |z| and may approach it from below.
+	bound := initFrom(z)
+	if len(a) == len(b)+1 {
+		bound = z.Abs()
+	}
+
+	s := one.Copy()
+	t := one.Copy()
+	top := 0
+	k := initFrom(z)
+	for i := 0; ; i++ {
+		if i > MaxHypergeometricIterations {
+			panic(fmt.Sprintf("failed to converge hypergeometric(%v, %v, %v)", a, b, z))
+		}
+		k.SetInt64(int64(i))
+		num := z.Copy()
+		for _, v := range aw {
+			num = num.mul(v.Add(k))
+		}
+		if num.IsZero() {
+			// the polynomial ends here
+			return s, top - s.exponent + digits(i)
+		}
+		den := k.AddInt64(1)
+		for _, v := range bw {
+			den = den.mul(v.Add(k))
+		}
+		ratio := num.div(den)
+		t = t.mul(ratio)
+		s = s.Add(t)
+		top = max(top, t.exponent)
+
+		if t.exponent >= s.exponent-int(z.precision)-1 || float64(i) <= largest {
+			continue
+		}
+		// the rest of the sum is at most t·ρ/(1-ρ) for the ratio ρ
+		rho := ratio.Abs().Max(bound)
+		if d := one.Sub(rho); !d.negative && !d.IsZero() && t.exponent-d.exponent < s.exponent-int(z.precision)-1 {
+			return s, top - s.exponent + digits(i)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestHypergeometric(t *testing.T) {
+	tests := []struct {
+		a, b    []string
+		z, want string
+	}{
+		{nil, nil, "-2", "1.353352832366126918939994949724844e-1"},
+		{[]string{"0.7"}, nil, "-2.5", "4.160565552060244766394543883881377e-1"},
+		{[]string{"2"}, nil, "3", "2.5e-1"},
+		{[]string{"0.7"}, nil, "1", "∞"},
+		{nil, []string{"2.5"}, "7", "8.630781449551841300548694972298118e0"},
+		{nil, []string{"1.5"}, "-100", "4.564726253638138271880499919228412e-2"},
+		{nil, []string{"1"}, "-1e4", "-1.543743993056509159192284723134415e-2"},
+		{[]string{"0.3"}, []string{"1.7"}, "12.5", "2.60298489361109977103000911078205e3"},
+		{[]string{"0.3"}, []string{"1.7"}, "-30", "3.676527004549639465407590798928315e-1"},
+		{[]string{"1"}, []string{"2"}, "-1e3", "1e-3"},
+		{[]string{"0.5", "0.25"}, []string{"1.75"}, "0.9", "1.107177441570612052306605491873282e0"},
+		{[]string{"0.5", "0.25"}, []string{"1.75"}, "-0.4", "9.7469332353943202004562103164538e-1"},
+		{[]string{"0.5", "0.25"}, []string{"1.75"}, "-3", "8.807745291998559150977167690847344e-1"},
+		{[]string{"1", "1"}, []string{"2"}, "-1e3", "6.908754779315220585220783762973628e-3"},
+		{[]string{"1", "1"}, []string{"2"}, "-1e5", "1.151293546492022875342079062675499e-4"},
+		{[]string{"1", "1"}, []string{"2"}, "0.9999", "9.211261498125995335605526371374594e0"},
+		{[]string{"1", "1"}, []string{"2"}, "-1e40000", "NaN"},
+		{[]string{"0.5", "0.5"}, []string{"1"}, "0.999999", "5.280157154771866275664619917622563e0"},
+		{[]string{"0.3", "0.7"}, []string{"1.6"}, "0.99", "1.340686385776756301383716802199127e0"},
+		{[]string{"0.3", "0.7"}, []string{"1.6"}, "-1e20", "1.701301606298484443656209736123048e-6"},
+		{[]string{"-0.5", "2.25"}, []string{"1.2"}, "0.995", "-6.8580485678106185839641040232225e0"},
+		{[]string{"-1.5", "2.5"}, []string{"1.5"}, "0.9999", "-9.998e-3"},
+		{[]string{"0.5", "0.5"}, []string{"2"}, "1", "1.273239544735162686151070106980115e0"},
+		{[]string{"1", "2"}, []string{"3"}, "1", "NaN"},
+		{[]string{"1", "2"}, []string{"3"}, "1.5", "NaN"},
+		{[]string{"1.5", "2.25", "-0.75"}, []string{"3.5", "1.25"}, "0.5", "6.928308828471556316328867747835725e-1"},
+		{[]string{"1", "2", "3"}, []string{"4", "5", "6"}, "-50", "3.202506015676486789734267119705652e-1"},
+		{[]string{"-3", "2.5"}, []string{"1.5"}, "4", "-9.9e1"},
+		{[]string{"-4"}, []string{"0.5", "-7.5"}, "-3", "-9.276723276723276723276723276723277e-1"},
+		{[]string{"-2", "2"}, []string{"-3"}, "0.5", "1.916666666666666666666666666666667e0"},
+		{[]string{"1", "2"}, []string{"-3"}, "0.5", "NaN"},
+		{[]string{"1", "2", "3"}, []string{"3"}, "0.1", "NaN"},
+		{[]string{"1", "1", "1"}, []string{"2", "2"}, "0.99", "1.604672169774116491950736842404598e0"},
+		{[]string{"1", "1", "1"}, []string{"2", "2"}, "0.9999", "NaN"},
+		{[]string{"2", "3"}, []string{"4"}, "0", "1e0"},
+		{[]string{"2", "3"}, []string{"4"}, "inf", "NaN"},
+	}
+
+	parse := func(s []string) []*Real {
+		var r []*Real
+		for _, v := range s {
+			x, err := ParseReal(v, DefaultPrecision)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r = append(r, x)
+		}
+		return r
+	}
+	for _, v := range tests {
+		z := parse([]string{v.z})[0]
+		if f := Hypergeometric(parse(v.a), parse(v.b), z); f.String() != v.want {
+			t.Fatal("invalid hypergeometric", v.a, v.b, v.z, f)
+		}
+	}
+}
+
+func TestHypergeometricHighPrecision(t *testing.T) {
+	a := []*Real{NewFloat64(0.5), NewFloat64(0.25)}
+	b := []*Real{NewFloat64(1.75)}
+	z, err := ParseReal("0.6", 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := Hypergeometric(a, b, z)
+
+	if fmt.Sprintf("%.60e", f) != "1.05587481158272624947504764785243800203934567247449465705006e0" {
+		t.Fatal("invalid hypergeometric", fmt.Sprintf("%.60e", f))
+	}
+}
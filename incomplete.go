@@ -0,0 +1,353 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import "fmt"
+
+// MaxIncompleteIterations is the maximum number of terms in the series and
+// continued fractions used for the incomplete gamma and beta functions. If
+// this limit is reached, the function will panic.
+const MaxIncompleteIterations = 100000
+
+// The largest number of digits in the terms of the polynomial used to compute
+// Iₓ(a, b) exactly for integer a and b. Beyond it, the continued fraction is
+// cheaper.
+const maxExactBetaDigits = 4000
+
+// Return the regularized lower incomplete gamma function
+//
+//	P(a, x) = γ(a, x)/Γ(a) = 1/Γ(a) ∫ t^(a-1)·e^-t dt, for t in [0, x]
+//
+// The result is correctly rounded, and has the precision and rounding mode of
+// x. P is NaN unless a > 0 and x ≥ 0.
+func GammaP(a, x *Real) *Real {
+	return incompleteGamma(a, x, false)
+}
+
+// Return the regularized upper incomplete gamma function Q(a, x) = 1 - P(a, x).
+// The result is correctly rounded, and has the precision and rounding mode of
+// x. Q is computed directly where it is small, so it stays accurate far into
+// the upper tail. Q is NaN unless a > 0 and x ≥ 0.
+func GammaQ(a, x *Real) *Real {
+	return incompleteGamma(a, x, true)
+}
+
+func incompleteGamma(a, x *Real, upper bool) *Real {
+	a.validate()
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		return x.working(w).incompleteGamma(a, upper)
+	})
+}
+
+func (x *Real) incompleteGamma(a *Real, upper bool) (*Real, int) {
+	z := initFrom(x)
+	if x.IsNaN() || x.negative || a.form != FormReal || a.negative || a.IsZero() {
+		z.form = FormNaN
+		return z, exactDigits
+	} else if x.IsZero() || x.IsInf() {
+		// P(a, 0) = 0 and P(a, ∞) = 1
+		if x.IsZero() == upper {
+			z.SetInt64(1)
+		}
+		return z, exactDigits
+	}
+
+	aw := a.working(x.precision)
+	one := initFrom(x)
+	one.SetInt64(1)
+	if x.gammaQFraction(aw) {
+		z, r := x.gammaFraction(aw)
+		if !upper {
+			return complement(z, r)
+		}
+		return z, r
+	} else if !upper {
+		return x.gammaSeries(aw)
+	} else if aw.Compare(one) == -1 && x.Compare(aw.Add(one)) == -1 {
+		return x.gammaQSmall(a)
+	}
+
+	// Q = 1 - P cancels the digits of P up to the size of Q, which is at
+	// least about 0.1 for x < a+1, and about e^-x beyond.
+	p := x.precision
+	if x.Compare(aw.Add(one)) >= 0 {
+		p += cancellationDigits(x, 1)
+	}
+	z, r := x.working(p).gammaSeries(a.working(p))
+	z, r = complement(z, r)
+	z.precision = x.precision
+	return z, r - int(p-x.precision)
+}
+
+// Returns true if Q(a, x) should be computed with its continued fraction,
+// which converges quickly once x is beyond a+1 and a sizable fraction of the
+// precision. Below that, the series for P converges quickly instead.
+func (x *Real) gammaQFraction(a *Real) bool {
+	if x.Compare(a.AddInt64(1)) == -1 {
+		return false
+	} else if x.exponent > 8 {
+		return true
+	}
+	f, _ := x.Float64()
+	return f > float64(x.precision)/4
+}
+
+// Return 1-z, where z has r correct digits and 0 < z ≤ 1, along with the
+// correct digits remaining after cancellation.
+func complement(z *Real, r int) (*Real, int) {
+	one := initFrom(z)
+	one.SetInt64(1)
+	c := one.Sub(z)
+	if c.IsZero() {
+		return c, 0
+	}
+	return c, r - max(-c.exponent, 0)
+}
+
+// Return e^t, where t is the sum of the given terms, along with the number of
+// digits lost to the absolute error of t, which grows with the largest term.
+func expTerms(t ...*Real) (*Real, int) {
+	s := initFrom(t[0])
+	e := t[0].exponent
+	for _, v := range t {
+		s = s.Add(v)
+		e = max(e, v.exponent)
+	}
+	return s.exp(), max(e+1, 0)
+}
+
+// Return P(a, x) using the series
+//
+//	P(a, x) = x^a·e^-x/Γ(a+1) · Σ xⁿ/((a+1)(a+2)···(a+n))
+//
+// whose terms are all positive.
+func (x *Real) gammaSeries(a *Real) (*Real, int) {
+	one := initFrom(x)
+	one.SetInt64(1)
+	t := one
+	s := one
+	an := a
+	for n := 1; ; n++ {
+		if n > MaxIncompleteIterations {
+			panic(fmt.Sprintf("failed to converge gammap(%v, %v)", a, x))
+		}
+		an = an.Add(one)
+		t = t.mul(x).div(an)
+		if t.exponent < s.exponent-int(x.precision)-1 {
+			break
+		}
+		s = s.Add(t)
+	}
+
+	xn := x.Copy()
+	xn.negate()
+	lg, _ := a.Add(one).lgamma()
+	lg.negate()
+	f, loss := expTerms(a.mul(x.ln()), xn, lg)
+	return f.mul(s), int(x.precision) - internalPrecisionBuffer - 2 - loss
+}
+
+// Return Q(a, x) for x ≥ a+1 using the continued fraction
+//
+//	Q(a, x) = x^a·e^-x/Γ(a) · 1/(x+1-a - 1(1-a)/(x+3-a - 2(2-a)/(x+5-a - ...)))
+//
+// evaluated with the modified Lentz method.
+func (x *Real) gammaFraction(a *Real) (*Real, int) {
+	two := initFrom(x)
+	two.SetInt64(2)
+	b := x.Sub(a).AddInt64(1)
+	f := b
+	c := b
+	d := initFrom(x)
+	for i := int64(1); ; i++ {
+		if i > MaxIncompleteIterations {
+			panic(fmt.Sprintf("failed to converge gammaq(%v, %v)", a, x))
+		}
+		// aᵢ = -i(i-a), bᵢ = x+2i+1-a
+		ai := a.AddInt64(-i).mul(NewInt64(i))
+		b = b.Add(two)
+		d = b.Add(ai.mul(d)).reciprocal()
+		c = b.Add(ai.div(c))
+		delta := c.mul(d)
+		f = f.mul(delta)
+		if r := delta.SubInt64(1); r.IsZero() || r.exponent < -int(x.precision)-1 {
+			break
+		}
+	}
+
+	xn := x.Copy()
+	xn.negate()
+	lg, _ := a.lgamma()
+	lg.negate()
+	g, loss := expTerms(a.mul(x.ln()), xn, lg)
+	return g.div(f), int(x.precision) - internalPrecisionBuffer - 2 - loss
+}
+
+// Return Q(a, x) for a < 1 and x < a+1, where P(a, x) is near 1 for small a.
+// With u = a·ln x - ln Γ(a+1),
+//
+//	Q(a, x) = -expm1(u) - a·e^u·Σ (-x)ⁿ/(n!(a+n)), for n ≥ 1
+//
+// ln Γ(a+1) is about -γa, and is computed with -log₁₀(a) more digits so that
+// it keeps the relative precision of a.
+func (x *Real) gammaQSmall(a *Real) (*Real, int) {
+	p := x.precision + uint(max(-a.exponent, 0))
+	xw := x.working(p)
+	aw := a.working(p)
+	one := initFrom(xw)
+	one.SetInt64(1)
+
+	lg, _ := aw.Add(one).lgamma()
+	u := aw.mul(xw.ln()).Sub(lg)
+	e := u.expm1()
+	e.negate()
+
+	xn := xw.Copy()
+	xn.negate()
+	t := one
+	s := initFrom(xw)
+	for n := uint64(1); ; n++ {
+		if n > MaxIncompleteIterations {
+			panic(fmt.Sprintf("failed to converge gammaq(%v, %v)", a, x))
+		}
+		t = t.mul(xn).divUint64(n)
+		v := t.div(aw.Add(NewUint64(n)))
+		if v.exponent < s.exponent-int(p)-1 {
+			break
+		}
+		s = s.Add(v)
+	}
+
+	g := aw.mul(u.exp()).mul(s)
+	z := e.Sub(g)
+	z.precision = x.precision
+	return z, int(x.precision) - internalPrecisionBuffer - 2 - max(e.exponent-z.exponent, g.exponent-z.exponent, 0)
+}
+
+// Return the regularized incomplete beta function
+//
+//	Iₓ(a, b) = 1/B(a, b) ∫ t^(a-1)·(1-t)^(b-1) dt, for t in [0, x]
+//
+// The result is correctly rounded, and has the precision and rounding mode of
+// x. For integer a and b, Iₓ is a polynomial in x, and is computed exactly
+// when its terms are small. Iₓ is NaN unless a, b > 0 and 0 ≤ x ≤ 1. The
+// upper tail 1 - Iₓ(a, b) is I₁₋ₓ(b, a).
+func BetaInc(a, b, x *Real) *Real {
+	a.validate()
+	b.validate()
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		return x.working(w).betaInc(a, b)
+	})
+}
+
+func (x *Real) betaInc(a, b *Real) (*Real, int) {
+	z := initFrom(x)
+	c := x.Compare(NewInt64(1))
+	if x.IsNaN() || x.negative || c == 1 || a.form != FormReal || b.form != FormReal ||
+		a.negative || a.IsZero() || b.negative || b.IsZero() {
+		z.form = FormNaN
+		return z, exactDigits
+	} else if x.IsZero() {
+		return z, exactDigits
+	} else if c == 0 {
+		z.SetInt64(1)
+		return z, exactDigits
+	}
+
+	y := x.reflect()
+	m, ok1 := a.smallInteger()
+	n, ok2 := b.smallInteger()
+	if ok1 && ok2 && (m+n-1)*(x.ndigits()+y.ndigits()) <= maxExactBetaDigits {
+		return x.betaIncExact(y, m, m+n-1), exactDigits
+	}
+
+	// The continued fraction converges quickly for x < (a+1)/(a+b+2), and
+	// Iₓ(a, b) = 1 - I₁₋ₓ(b, a) otherwise.
+	aw := a.working(x.precision)
+	bw := b.working(x.precision)
+	t := aw.AddInt64(1).div(aw.Add(bw).AddInt64(2))
+	if x.Compare(t) == 1 {
+		return complement(y.betaFraction(bw, aw))
+	}
+	return x.betaFraction(aw, bw)
+}
+
+// Return Iₓ(a, b) using the continued fraction
+//
+//	Iₓ(a, b) = x^a·(1-x)^b/(a·B(a, b)) · 1/(1 + d₁/(1 + d₂/(1 + ...)))
+//	d₂ₘ₊₁ = -(a+m)(a+b+m)x / ((a+2m)(a+2m+1))
+//	d₂ₘ = m(b-m)x / ((a+2m-1)(a+2m))
+//
+// evaluated with the modified Lentz method.
+func (x *Real) betaFraction(a, b *Real) (*Real, int) {
+	one := initFrom(x)
+	one.SetInt64(1)
+	ab := a.Add(b)
+	f := one
+	c := one
+	d := initFrom(x)
+	for i := int64(1); ; i++ {
+		if i > MaxIncompleteIterations {
+			panic(fmt.Sprintf("failed to converge betainc(%v, %v, %v)", a, b, x))
+		}
+		var di *Real
+		if i%2 != 0 {
+			m := (i - 1) / 2
+			di = a.AddInt64(m).mul(ab.AddInt64(m)).mul(x)
+			di = di.div(a.AddInt64(2 * m).mul(a.AddInt64(2*m + 1)))
+			di.negate()
+		} else {
+			m := i / 2
+			di = b.AddInt64(-m).mul(NewInt64(m)).mul(x)
+			di = di.div(a.AddInt64(2*m - 1).mul(a.AddInt64(2 * m)))
+		}
+		d = one.Add(di.mul(d)).reciprocal()
+		c = one.Add(di.div(c))
+		delta := c.mul(d)
+		f = f.mul(delta)
+		if r := delta.Sub(one); r.IsZero() || r.exponent < -int(x.precision)-1 {
+			break
+		}
+	}
+
+	la, _ := a.lgamma()
+	lb, _ := b.lgamma()
+	lab, _ := ab.lgamma()
+	la.negate()
+	lb.negate()
+	g, loss := expTerms(a.mul(x.ln()), b.mul(x.reflect().ln()), la, lb, lab)
+	return g.div(a.mul(f)), int(x.precision) - internalPrecisionBuffer - 2 - loss
+}
+
+// Return Iₓ(a, b) exactly for the integer a ≥ 1 and n = a+b-1, where y = 1-x,
+// from the binomial distribution,
+//
+//	Iₓ(a, b) = Σ C(n, j)·x^j·y^(n-j), for j in [a, n]
+//
+// The sum is evaluated as x^a·h, where h is computed by Horner's rule from
+// h = 1 and h = h·x + C(n, j)·y^(n-j) for j = n-1 down to a.
+func (x *Real) betaIncExact(y *Real, a, n int) *Real {
+	// row n of Pascal's triangle
+	row := make([]*Real, n+1)
+	for i := range row {
+		row[i] = NewUint64(1)
+		for j := i - 1; j > 0; j-- {
+			row[j] = addExact(row[j], row[j-1])
+		}
+	}
+
+	h := NewUint64(1)
+	yk := NewUint64(1)
+	for j := n - 1; j >= a; j-- {
+		yk = mulExact(yk, y)
+		h = addExact(mulExact(h, x), mulExact(row[j], yk))
+	}
+	for range a {
+		h = mulExact(h, x)
+	}
+	h.precision = x.precision
+	h.mode = x.mode
+	return h
+}
@@ -0,0 +1,141 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestIncompleteGamma(t *testing.T) {
+	tests := []struct {
+		a, x, p, q string
+	}{
+		{"1", "1", "6.321205588285576784044762298385391e-1", "3.678794411714423215955237701614609e-1"},
+		{"0.5", "2", "9.544997361036415855994347256669331e-1", "4.550026389635841440056527433306687e-2"},
+		{"3", "0.1", "1.546530702646716535047893116875336e-4", "9.998453469297353283464952106883125e-1"},
+		{"3", "12", "9.994777419499671021705120038048015e-1", "5.222580500328978294879961951984702e-4"},
+		{"10", "30", "9.999928782491371844229083533391657e-1", "7.121750862815577091646660834340291e-6"},
+		{"1e-10", "0.5", "9.999999999440226405194501209067949e-1", "5.597735948054987909320508695837615e-11"},
+		{"1000", "1100", "9.989406767460700226511250666200496e-1", "1.059323253929977348874933379950363e-3"},
+		{"1", "0", "0", "1e0"},
+		{"1", "inf", "1e0", "0"},
+		{"0", "1", "NaN", "NaN"},
+		{"1", "-1", "NaN", "NaN"},
+	}
+
+	for _, v := range tests {
+		a, err := ParseReal(v.a, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		x, err := ParseReal(v.x, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := GammaP(a, x); z.String() != v.p {
+			t.Fatal("invalid gammap", v.a, v.x, z)
+		}
+		if z := GammaQ(a, x); z.String() != v.q {
+			t.Fatal("invalid gammaq", v.a, v.x, z)
+		}
+	}
+}
+
+func TestIncompleteGammaTails(t *testing.T) {
+	tests := []struct {
+		a, x, p, q string
+	}{
+		{"50", "1", "1.233750897909735127206757172338852e-65", ""},
+		{"0.5", "50", "", "1.523970604832105213194668650319862e-23"},
+		{"0.5", "8", "", "6.33424836662398425075415134443026e-5"},
+		{"1", "1e5", "", "3.562949565309373121071174418748652e-43430"},
+	}
+
+	for _, v := range tests {
+		a, err := ParseReal(v.a, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		x, err := ParseReal(v.x, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := GammaP(a, x); v.p != "" && z.String() != v.p {
+			t.Fatal("invalid gammap", v.a, v.x, z)
+		}
+		if z := GammaQ(a, x); v.q != "" && z.String() != v.q {
+			t.Fatal("invalid gammaq", v.a, v.x, z)
+		}
+	}
+}
+
+func TestIncompleteGammaHighPrecision(t *testing.T) {
+	a, err := ParseReal("2.5", 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x, err := ParseReal("3.5", 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	z := GammaQ(a, x)
+
+	if fmt.Sprintf("%.60e", z) != "2.20640307936710790794851753642055733347111835640250896663187e-1" {
+		t.Fatal("invalid gammaq", fmt.Sprintf("%.60e", z))
+	}
+}
+
+func TestBetaInc(t *testing.T) {
+	tests := []struct {
+		a, b, x, want string
+	}{
+		{"2", "3", "0.4", "5.248e-1"},
+		{"1", "1", "0.5", "5e-1"},
+		{"10", "20", "0.1", "3.325960345446000969615235e-4"},
+		{"50", "50", "0.45", "1.586521989370987946976520097015306e-1"},
+		{"0.5", "0.5", "0.3", "3.690101195655453827554305587787365e-1"},
+		{"2.5", "1.5", "0.7", "5.843121477019746533444137260540338e-1"},
+		{"0.5", "3", "0.01", "1.8625375e-1"},
+		{"1e-5", "2", "0.5", "9.999980685175597568237821092653777e-1"},
+		{"300.5", "200.5", "0.6", "5.012150797987177103242830017740345e-1"},
+		{"2", "2", "0", "0"},
+		{"2", "2", "1", "1e0"},
+		{"2", "2", "1.5", "NaN"},
+		{"0", "2", "0.5", "NaN"},
+	}
+
+	for _, v := range tests {
+		a, err := ParseReal(v.a, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := ParseReal(v.b, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		x, err := ParseReal(v.x, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := BetaInc(a, b, x); z.String() != v.want {
+			t.Fatal("invalid betainc", v.a, v.b, v.x, z)
+		}
+	}
+}
+
+func TestBetaIncModeZero(t *testing.T) {
+	// the binomial sum is exact, so directed rounding cannot step below it
+	a := NewInt64(3)
+	b := NewInt64(4)
+	x, err := ParseReal("0.3", DefaultPrecision)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x.SetMode(ModeZero)
+	if z := BetaInc(a, b, x); z.String() != "2.5569e-1" {
+		t.Fatal("invalid betainc", z)
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import (
+	"fmt"
+	"math"
+)
+
+// MaxLambertWIterations is the maximum number of iterations used to refine the
+// Lambert W function. If this limit is reached, the function will panic.
+const MaxLambertWIterations = 1000
+
+// Return the principal branch of the Lambert W function, W₀(x), the solution
+// w ≥ -1 of w·e^w = x. The result is correctly rounded. W₀ is NaN for
+// x < -1/e.
+func (x *Real) LambertW0() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		return x.working(w).lambertW(false)
+	})
+}
+
+// Return the lower branch of the Lambert W function, W₋₁(x), the solution
+// w ≤ -1 of w·e^w = x. The result is correctly rounded. W₋₁(0) is -Inf, and
+// W₋₁ is NaN outside of [-1/e, 0].
+func (x *Real) LambertWm1() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		return x.working(w).lambertW(true)
+	})
+}
+
+func (x *Real) lambertW(lower bool) (*Real, int) {
+	z := initFrom(x)
+	if x.IsNaN() || (x.IsInf() && (x.negative || lower)) || (lower && !x.negative && !x.IsZero()) {
+		z.form = FormNaN
+		return z, exactDigits
+	} else if x.IsInf() {
+		z.form = FormInf
+		return z, exactDigits
+	} else if x.IsZero() {
+		if lower {
+			z.form = FormInf
+			z.negative = true
+		}
+		return z, exactDigits
+	}
+
+	one := initFrom(x)
+	one.SetInt64(1)
+	if x.negative {
+		// The branches meet at x = -1/e, where W = -1. With q = 1 + e·x,
+		//
+		//	W = -1 + p - p²/3 + 11p³/72 - ..., where p = ±√(2q)
+		q := one.Add(E(x.precision).mul(x))
+		if q.IsZero() || q.exponent < internalPrecisionBuffer-int(x.precision) {
+			// too close to -1/e to tell which side x is on
+			z.SetInt64(-1)
+			return z, 0
+		} else if q.negative {
+			z.form = FormNaN
+			return z, exactDigits
+		} else if q.exponent < -1 {
+			p := q.Add(q).sqrt()
+			if lower {
+				p.negate()
+			}
+			p2 := p.mul(p)
+			z = p.Sub(one).Sub(p2.divUint64(3)).Add(p2.mul(p).mul(NewInt64(11)).divUint64(72))
+			z = x.lambertWHalley(z)
+			return z, x.lambertWDigits(z)
+		}
+	}
+
+	z = x.lambertWHalley(x.lambertWSeed(lower))
+	return z, x.lambertWDigits(z)
+}
+
+// Return the number of correct digits of z = W(x). The relative error of
+// w·e^w is amplified by 1/|1+W| in W, which is large near the branch point.
+func (x *Real) lambertWDigits(z *Real) int {
+	r := int(x.precision) - internalPrecisionBuffer - 2
+	if d := z.AddInt64(1); d.IsZero() {
+		return 0
+	} else if d.exponent < 0 {
+		r += d.exponent
+	}
+	return r
+}
+
+// Return a float64 estimate of W(x), away from the branch point. Beyond the
+// range of float64, the leading terms of the asymptotic expansion are used
+// instead.
+func (x *Real) lambertWSeed(lower bool) *Real {
+	z := initFrom(x)
+	if x.exponent > -300 && x.exponent < 300 {
+		f, _ := x.Float64()
+		z.SetFloat64(lambertWFloat64(f, lower))
+		return z
+	} else if x.exponent < 0 && !lower {
+		// W₀(x) = x - x² + ..., so x is already accurate to 300 digits
+		return x.Copy()
+	}
+
+	m := x.Abs()
+	m.exponent = 0
+	f, _ := m.Float64()
+	z.SetFloat64(lambertWAsymptotic(math.Log(f) + float64(x.exponent)*math.Ln10))
+	return z
+}
+
+// Return W(x) for a float64 x, using Halley's method from a rough estimate.
+func lambertWFloat64(x float64, lower bool) float64 {
+	var w float64
+	if lower || x > math.E {
+		w = lambertWAsymptotic(math.Log(math.Abs(x)))
+	} else {
+		w = math.Log1p(x)
+	}
+	for range 100 {
+		e := math.Exp(w)
+		f := w*e - x
+		step := f / (e*(w+1) - (w+2)*f/(2*w+2))
+		w -= step
+		if math.Abs(step) <= 1e-15*math.Abs(w) {
+			break
+		}
+	}
+	return w
+}
+
+// Return the leading terms of the asymptotic expansion of W for large |L₁|,
+//
+//	W ≈ L₁ - L₂ + L₂/L₁
+//
+// where L₁ = ln|x| and L₂ = ln|L₁|. It applies to W₀ as x → ∞, and to W₋₁ as
+// x → 0.
+func lambertWAsymptotic(l1 float64) float64 {
+	l2 := math.Log(math.Abs(l1))
+	return l1 - l2 + l2/l1
+}
+
+// Return the solution of w·e^w = x, refining the estimate z with Halley's
+// method. With f(w) = w·e^w - x, each step is
+//
+//	w = w - f / (e^w·(w+1) - (w+2)·f/(2w+2))
+func (x *Real) lambertWHalley(z *Real) *Real {
+	for i := 0; ; i++ {
+		if i > MaxLambertWIterations {
+			panic(fmt.Sprintf("failed to converge lambertw(%v)", x))
+		}
+		ez := z.exp()
+		f := z.mul(ez).Sub(x)
+		z1 := z.AddInt64(1)
+		d := ez.mul(z1).Sub(z1.AddInt64(1).mul(f).div(z1.Add(z1)))
+		step := f.div(d)
+		z = z.Sub(step)
+
+		// Convergence is cubic, so once the step is below half the
+		// precision, the remaining error is far below the last digit.
+		if step.IsZero() || step.exponent < z.exponent-int(x.precision)/2 {
+			return z
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestLambertW0(t *testing.T) {
+	tests := []struct {
+		x, want string
+	}{
+		{"0", "0"},
+		{"1e-20", "9.9999999999999999999e-21"},
+		{"-1e-20", "-1.00000000000000000001e-20"},
+		{"0.5", "3.517337112491958260249093009299511e-1"},
+		{"1", "5.671432904097838729999686622103555e-1"},
+		{"10", "1.74552800274069938307430126487539e0"},
+		{"-0.3", "-4.894022271802149690362312519962934e-1"},
+		{"-0.36787944117", "-9.999971997752715886211339968007103e-1"},
+		{"-0.3678794411714423215955237701614608", "-9.999999999999999808512808364024276e-1"},
+		{"-0.3678794411714423215955237701614609", "NaN"},
+		{"-1", "NaN"},
+		{"1e100", "2.248431064451185015393731343379557e2"},
+		{"1e1000", "2.294846671683506869652792785993617e3"},
+		{"1e-400", "1e-400"},
+		{"inf", "∞"},
+		{"-inf", "NaN"},
+	}
+
+	for _, v := range tests {
+		x, err := ParseReal(v.x, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := x.LambertW0(); z.String() != v.want {
+			t.Fatal("invalid lambertw0", v.x, z)
+		}
+	}
+}
+
+func TestLambertWm1(t *testing.T) {
+	tests := []struct {
+		x, want string
+	}{
+		{"0", "-∞"},
+		{"-1e-20", "-4.996298427667447244531514297262541e1"},
+		{"-1e-1000", "-2.310330238747840545898426886363906e3"},
+		{"-0.1", "-3.577152063957297218409391963511995e0"},
+		{"-0.2", "-2.542641357773526424293806156661848e0"},
+		{"-0.3", "-1.781337023421627611974170281512745e0"},
+		{"-0.36787944117", "-1.000002800229955926824084223915783e0"},
+		{"-0.3678794411714423215955237701614608", "-1.000000000000000019148719163597573e0"},
+		{"-0.3678794411714423215955237701614609", "NaN"},
+		{"1", "NaN"},
+		{"-inf", "NaN"},
+	}
+
+	for _, v := range tests {
+		x, err := ParseReal(v.x, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := x.LambertWm1(); z.String() != v.want {
+			t.Fatal("invalid lambertwm1", v.x, z)
+		}
+	}
+}
+
+func TestLambertWHighPrecision(t *testing.T) {
+	x, err := ParseReal("1", 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	z := x.LambertW0()
+
+	// the omega constant
+	if fmt.Sprintf("%.60e", z) != "5.67143290409783872999968662210355549753815787186512508135131e-1" {
+		t.Fatal("invalid lambertw0", fmt.Sprintf("%.60e", z))
+	}
+}
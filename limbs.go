@@ -0,0 +1,328 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import "math/bits"
+
+// A decimal significand stored as an unsigned integer in base 10⁹, least
+// significant limb first. Each limb holds nine decimal digits, so arithmetic
+// works on nine digits per machine operation. Limbs are normalized when the
+// most significant limb is nonzero, and zero is the empty slice.
+//
+// Operations on limbs return new slices, except for shr, inc, and addAt, which
+// reuse the storage of x. Each Real owns its significand, so it may round in
+// place.
+type limbs []uint32
+
+const (
+	limbDigits = 9             // decimal digits per limb
+	limbBase   = 1_000_000_000 // 10^limbDigits
+)
+
+// Powers of ten up to the limb base.
+var limbPow10 = [limbDigits + 1]uint32{
+	1, 10, 100, 1_000, 10_000, 100_000, 1_000_000, 10_000_000, 100_000_000, 1_000_000_000,
+}
+
+// Return the number of decimal digits in the limb d, which is 0 for 0.
+func limbLen(d uint32) int {
+	n := 0
+	for n < limbDigits && d >= limbPow10[n] {
+		n++
+	}
+	return n
+}
+
+// Remove the most significant zero limbs.
+func (x limbs) norm() limbs {
+	i := len(x)
+	for i > 0 && x[i-1] == 0 {
+		i--
+	}
+	return x[:i]
+}
+
+// Return the number of decimal digits in x.
+func (x limbs) ndigits() int {
+	if len(x) == 0 {
+		return 0
+	}
+	return (len(x)-1)*limbDigits + limbLen(x[len(x)-1])
+}
+
+// Return the decimal digit of x at position i, counting from the least
+// significant digit. Positions beyond x are zero.
+func (x limbs) digit(i int) byte {
+	if i < 0 || i/limbDigits >= len(x) {
+		return 0
+	}
+	return byte(x[i/limbDigits] / limbPow10[i%limbDigits] % 10)
+}
+
+// Returns true if any digit of x below position i is nonzero.
+func (x limbs) nonzeroBelow(i int) bool {
+	q, r := i/limbDigits, i%limbDigits
+	for j := 0; j < q && j < len(x); j++ {
+		if x[j] != 0 {
+			return true
+		}
+	}
+	return q < len(x) && x[q]%limbPow10[r] != 0
+}
+
+// Return the number of trailing zero digits of the nonzero x.
+func (x limbs) trailingZeros() int {
+	n := 0
+	for _, v := range x {
+		if v != 0 {
+			for v%10 == 0 {
+				v /= 10
+				n++
+			}
+			return n
+		}
+		n += limbDigits
+	}
+	return n
+}
+
+// Compare the integers x and y, returning -1, 0, or 1.
+func (x limbs) cmp(y limbs) int {
+	if len(x) != len(y) {
+		if len(x) < len(y) {
+			return -1
+		}
+		return 1
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		if x[i] != y[i] {
+			if x[i] < y[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// Compare x and y as significands, aligned at their leading digits, so that
+// 12 and 1200 are equal. It is the comparison of their digit strings, and does
+// not allocate.
+func cmpSignificands(x, y limbs) int {
+	s := x.ndigits() - y.ndigits()
+	if s < 0 {
+		return -cmpSignificands(y, x)
+	}
+
+	// compare x with y·10^s one limb at a time
+	q, r := s/limbDigits, s%limbDigits
+	for i := len(x) - 1; i >= 0; i-- {
+		var v uint32
+		if j := i - q; j >= 0 && j < len(y) {
+			v = y[j] % limbPow10[limbDigits-r] * limbPow10[r]
+		}
+		if j := i - q - 1; r != 0 && j >= 0 && j < len(y) {
+			v += y[j] / limbPow10[limbDigits-r]
+		}
+		if x[i] != v {
+			if x[i] < v {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// Return x + y.
+func addLimbs(x, y limbs) limbs {
+	if len(x) < len(y) {
+		x, y = y, x
+	}
+	z := make(limbs, len(x)+1)
+	var carry uint32
+	for i, v := range x {
+		t := v + carry
+		if i < len(y) {
+			t += y[i]
+		}
+		carry = 0
+		if t >= limbBase {
+			t -= limbBase
+			carry = 1
+		}
+		z[i] = t
+	}
+	z[len(x)] = carry
+	return z.norm()
+}
+
+// Return x - y, where x ≥ y.
+func subLimbs(x, y limbs) limbs {
+	z := make(limbs, len(x))
+	var borrow uint32
+	for i, v := range x {
+		s := borrow
+		if i < len(y) {
+			s += y[i]
+		}
+		borrow = 0
+		if v < s {
+			v += limbBase
+			borrow = 1
+		}
+		z[i] = v - s
+	}
+	return z.norm()
+}
+
+// Add y·10^(9i) to x in place. x must be long enough to hold the sum.
+func (x limbs) addAt(y limbs, i int) {
+	var carry uint32
+	for j := 0; j < len(y) || carry != 0; j++ {
+		t := x[i+j] + carry
+		if j < len(y) {
+			t += y[j]
+		}
+		carry = 0
+		if t >= limbBase {
+			t -= limbBase
+			carry = 1
+		}
+		x[i+j] = t
+	}
+}
+
+// Return x + 1, reusing the storage of x.
+func (x limbs) inc() limbs {
+	for i := range x {
+		x[i]++
+		if x[i] < limbBase {
+			return x
+		}
+		x[i] = 0
+	}
+	return append(x, 1)
+}
+
+// Return x·10ⁿ for n ≥ 0.
+func (x limbs) shl(n int) limbs {
+	if n == 0 || len(x) == 0 {
+		return x
+	}
+	q, r := n/limbDigits, n%limbDigits
+	z := make(limbs, len(x)+q+1)
+	if r == 0 {
+		copy(z[q:], x)
+		return z.norm()
+	}
+	m := uint64(limbPow10[r])
+	var carry uint64
+	for i, v := range x {
+		t := uint64(v)*m + carry
+		z[i+q] = uint32(t % limbBase)
+		carry = t / limbBase
+	}
+	z[len(x)+q] = uint32(carry)
+	return z.norm()
+}
+
+// Return x/10ⁿ for n ≥ 0, truncated, reusing the storage of x.
+func (x limbs) shr(n int) limbs {
+	if n == 0 {
+		return x
+	}
+	q, r := n/limbDigits, n%limbDigits
+	if q >= len(x) {
+		return x[:0]
+	}
+	if r == 0 {
+		copy(x, x[q:])
+		return x[:len(x)-q]
+	}
+
+	// Each limb is written only after the limbs it is made from are read.
+	d, m := limbPow10[r], limbPow10[limbDigits-r]
+	for i := 0; i < len(x)-q; i++ {
+		x[i] = x[i+q] / d
+		if i+q+1 < len(x) {
+			x[i] += x[i+q+1] % d * m
+		}
+	}
+	return x[:len(x)-q].norm()
+}
+
+// Return x/d and the remainder, for 0 < d ≤ 10^18.
+func (x limbs) divUint64(d uint64) (limbs, uint64) {
+	z := make(limbs, len(x))
+	var r uint64
+	for i := len(x) - 1; i >= 0; i-- {
+		// r < d, so r·limbBase + x[i] < d·2⁶⁴ and the quotient fits
+		hi, lo := bits.Mul64(r, limbBase)
+		lo, c := bits.Add64(lo, uint64(x[i]), 0)
+		var q uint64
+		q, r = bits.Div64(hi+c, lo, d)
+		z[i] = uint32(q)
+	}
+	return z.norm(), r
+}
+
+// Return the limbs of y, using buf as storage.
+func uint64Limbs(y uint64, buf *[3]uint32) limbs {
+	n := 0
+	for y != 0 {
+		buf[n] = uint32(y % limbBase)
+		y /= limbBase
+		n++
+	}
+	return buf[:n]
+}
+
+// Return the number of digits in the significand of x.
+func (x *Real) ndigits() int {
+	return x.significand.ndigits()
+}
+
+// Return the ith digit of the significand of x, counting from the leading
+// digit. Positions beyond the significand are zero.
+func (x *Real) digit(i int) byte {
+	return x.significand.digit(x.ndigits() - 1 - i)
+}
+
+// Return the digits of the significand of x, leading digit first.
+func (x *Real) significandDigits() []byte {
+	d := make([]byte, x.ndigits())
+	for i, j := len(d)-1, 0; i >= 0; j++ {
+		v := x.significand[j]
+		for k := 0; k < limbDigits && i >= 0; k++ {
+			d[i] = byte(v % 10)
+			v /= 10
+			i--
+		}
+	}
+	return d
+}
+
+// Set the significand of x from its digits, leading digit first, where the
+// exponent of x is that of the first digit. Leading zeros lower the exponent,
+// as they do when written out. The value is not rounded.
+func (x *Real) setSignificandDigits(d []byte) {
+	i := 0
+	for i < len(d) && d[i] == 0 {
+		i++
+	}
+	x.exponent -= i
+	d = d[i:]
+	for len(d) > 0 && d[len(d)-1] == 0 {
+		d = d[:len(d)-1]
+	}
+
+	s := make(limbs, (len(d)+limbDigits-1)/limbDigits)
+	for i := range d {
+		j := len(d) - 1 - i
+		s[j/limbDigits] += uint32(d[i]) * limbPow10[j%limbDigits]
+	}
+	x.significand = s.norm()
+}
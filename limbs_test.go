@@ -0,0 +1,98 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSignificandDigits(t *testing.T) {
+	d := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 1}
+	r := new(Real)
+	r.setSignificandDigits(append([]byte{0, 0}, append(d, 0, 0, 0)...))
+	if bytes.Compare(r.significandDigits(), d) != 0 {
+		t.Fatal("invalid significand", r.significandDigits())
+	}
+	if r.exponent != -2 {
+		t.Fatal("invalid exponent", r.exponent)
+	}
+	if len(r.significand) != 3 || r.significand[0] != 234567891 || r.significand[1] != 345678901 || r.significand[2] != 12 {
+		t.Fatal("invalid limbs", r.significand)
+	}
+	if r.digit(0) != 1 || r.digit(19) != 1 || r.digit(20) != 0 {
+		t.Fatal("invalid digit")
+	}
+}
+
+func TestShiftLimbs(t *testing.T) {
+	r := NewUint64(123456789123456789)
+	for n := 0; n < 30; n++ {
+		x := append(limbs(nil), r.significand...)
+		y := x.shl(n)
+		if y.ndigits() != 18+n || y.trailingZeros() != n {
+			t.Fatal("invalid shl", n, y)
+		}
+		if y = y.shr(n); y.cmp(r.significand) != 0 {
+			t.Fatal("invalid shr", n, y)
+		}
+		if cmpSignificands(x.shl(n), r.significand) != 0 {
+			t.Fatal("invalid cmpSignificands", n)
+		}
+	}
+}
+
+func TestCompareSignificands(t *testing.T) {
+	td := []struct {
+		x, y string
+		want int
+	}{
+		{"12", "1200", 0},
+		{"12", "1201", -1},
+		{"1234567891", "123456789", 1},
+		{"1234567890000000001", "123456789", 1},
+		{"123456788999999999", "123456789", -1},
+	}
+	for _, v := range td {
+		x, y := parse(t, v.x), parse(t, v.y)
+		if c := cmpSignificands(x.significand, y.significand); c != v.want {
+			t.Fatal("invalid cmpSignificands", v.x, v.y, c)
+		}
+		if c := cmpSignificands(y.significand, x.significand); c != -v.want {
+			t.Fatal("invalid cmpSignificands", v.y, v.x, c)
+		}
+	}
+}
+
+func TestMulLimbsCarry(t *testing.T) {
+	// (10^27-1)² = 10^54 - 2·10^27 + 1
+	x := limbs{999999999, 999999999, 999999999}
+	z := mulLimbs(x, x)
+	want := limbs{1, 0, 0, 999999998, 999999999, 999999999}
+	if z.cmp(want) != 0 {
+		t.Fatal("invalid mulLimbs", z)
+	}
+}
+
+func TestDivLimbsUint64(t *testing.T) {
+	x := limbs{999999999, 999999999, 999999999}
+	q, r := x.divUint64(999999999999999999)
+	if q.cmp(limbs{0, 1}) != 0 || r != 999999999 {
+		t.Fatal("invalid divUint64", q, r)
+	}
+	q, r = x.divUint64(7)
+	if q.cmp(limbs{142857142, 857142857, 142857142}) != 0 || r != 5 {
+		t.Fatal("invalid divUint64", q, r)
+	}
+}
+
+func parse(t *testing.T, s string) *Real {
+	t.Helper()
+	r, err := ParseReal(s, 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import (
+	"errors"
+	"math"
+)
+
+// Limits bound the size of values accepted from untrusted input, such as
+// strings passed to ParseRealLimits or gob payloads passed to GobDecodeLimits.
+// A zero value for any field disables that limit.
+type Limits struct {
+	MaxDigits    uint // maximum number of significand digits
+	MaxExponent  int  // maximum magnitude of the exponent
+	MaxPrecision uint // maximum precision, in decimal digits
+}
+
+// DefaultLimits are suggested limits for untrusted input. They are generous
+// enough for any reasonable workload while keeping a malicious payload from
+// exhausting memory or overflowing exponent arithmetic.
+var DefaultLimits = Limits{
+	MaxDigits:    1 << 20,
+	MaxExponent:  math.MaxInt32,
+	MaxPrecision: 1 << 20,
+}
+
+// GobLimits are the limits GobDecode checks decoded values against, and so
+// apply to values decoded with the encoding/gob package. Values beyond them
+// can still be constructed and encoded, but decode only once GobLimits is
+// raised. A zero value disables the limits.
+var GobLimits = DefaultLimits
+
+// MaxFormatDigits is the maximum number of digits Format will write when
+// expanding a number with the 'd' and 'f' verbs. Numbers that would expand
+// beyond this limit are written as an error string instead, in the same
+// spirit as the fmt package's "%!verb(...)" errors. A value of 0 disables the
+// limit.
+var MaxFormatDigits = 1 << 20
+
+var (
+	ErrInvalidDigit   = errors.New("invalid significand digit")
+	ErrInvalidForm    = errors.New("invalid form")
+	ErrTooManyDigits  = errors.New("too many significand digits")
+	ErrExponentRange  = errors.New("exponent out of range")
+	ErrPrecisionRange = errors.New("precision out of range")
+)
+
+// Check that the given number of digits is within limits.
+func (l Limits) checkDigits(n int) error {
+	if l.MaxDigits != 0 && uint(n) > l.MaxDigits {
+		return ErrTooManyDigits
+	}
+	return nil
+}
+
+// Check that the given exponent is within limits.
+func (l Limits) checkExponent(e int) error {
+	if l.MaxExponent != 0 && (e > l.MaxExponent || e < -l.MaxExponent) {
+		return ErrExponentRange
+	}
+	return nil
+}
+
+// Check that the given precision is within limits.
+func (l Limits) checkPrecision(p uint) error {
+	if l.MaxPrecision != 0 && p > l.MaxPrecision {
+		return ErrPrecisionRange
+	}
+	return nil
+}
+
+// Check that x, with the significand digits d, is a well formed value within
+// limits. Used to validate values that are constructed directly from untrusted
+// input.
+func (l Limits) check(x *Real, d []byte) error {
+	for _, v := range d {
+		if v > 9 {
+			return ErrInvalidDigit
+		}
+	}
+	switch x.form {
+	case FormReal, FormNaN, FormInf:
+	default:
+		return ErrInvalidForm
+	}
+	switch x.mode {
+	case ModeNearestEven, ModeNearest, ModeZero:
+	default:
+		return ErrInvalidMode
+	}
+	if err := l.checkDigits(len(d)); err != nil {
+		return err
+	}
+	if err := l.checkExponent(x.exponent); err != nil {
+		return err
+	}
+	return l.checkPrecision(x.precision)
+}
@@ -0,0 +1,218 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"testing"
+)
+
+func TestParseRealLimitsExponent(t *testing.T) {
+	_, err := ParseRealLimits("1e9000000000", DefaultPrecision, DefaultLimits)
+	if err != ErrExponentRange {
+		t.Fatal("expected exponent error", err)
+	}
+
+	// the exponent is checked after normalization
+	_, err = ParseRealLimits("0.001e12", DefaultPrecision, Limits{MaxExponent: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParseRealLimitsDigits(t *testing.T) {
+	_, err := ParseRealLimits("123456", DefaultPrecision, Limits{MaxDigits: 5})
+	if err != ErrTooManyDigits {
+		t.Fatal("expected digits error", err)
+	}
+
+	x, err := ParseRealLimits("12345", DefaultPrecision, Limits{MaxDigits: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x.String() != "1.2345e4" {
+		t.Fatal("invalid parse", x)
+	}
+}
+
+func TestParseRealLimitsPrecision(t *testing.T) {
+	_, err := ParseRealLimits("1", 1000, Limits{MaxPrecision: 100})
+	if err != ErrPrecisionRange {
+		t.Fatal("expected precision error", err)
+	}
+}
+
+func encodeReal(t *testing.T, x *Real) []byte {
+	b := bytes.Buffer{}
+	enc := gob.NewEncoder(&b)
+	err := enc.Encode(x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b.Bytes()
+}
+
+func decodeReal(b []byte) (*Real, error) {
+	z := new(Real)
+	dec := gob.NewDecoder(bytes.NewReader(b))
+	err := dec.Decode(z)
+	return z, err
+}
+
+func TestGobDecodeInvalidDigit(t *testing.T) {
+	x := NewInt64(1234)
+	b, err := x.GobEncode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the significand is encoded one digit per byte
+	i := bytes.Index(b, []byte{1, 2, 3, 4})
+	if i < 0 {
+		t.Fatal("significand not found")
+	}
+	b[i+2] = 42
+
+	if err := new(Real).GobDecode(b); err != ErrInvalidDigit {
+		t.Fatal("expected digit error", err)
+	}
+}
+
+func TestGobDecodeInvalidForm(t *testing.T) {
+	x := NewInt64(1234)
+	x.form = 7
+
+	_, err := decodeReal(encodeReal(t, x))
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestGobDecodeLimits(t *testing.T) {
+	x := NewInt64(1234)
+	x.precision = 1 << 30
+
+	b, err := x.GobEncode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	z := NewInt64(5)
+	err = z.GobDecodeLimits(b, DefaultLimits)
+	if err != ErrPrecisionRange {
+		t.Fatal("expected precision error", err)
+	}
+	if z.String() != "5e0" {
+		t.Fatal("value modified on error", z)
+	}
+
+	x.precision = DefaultPrecision
+	x.exponent = 1 << 40
+	b, err = x.GobEncode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = z.GobDecodeLimits(b, DefaultLimits)
+	if err != ErrExponentRange {
+		t.Fatal("expected exponent error", err)
+	}
+
+	err = z.GobDecodeLimits(b, Limits{})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFormatLimit(t *testing.T) {
+	x, err := ParseReal("1e9000000000", DefaultPrecision)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := fmt.Sprintf("%f", x)
+	if s != "%!f(number: exceeds MaxFormatDigits)" {
+		t.Fatal("invalid format", s)
+	}
+	s = fmt.Sprintf("%d", x)
+	if s != "%!d(number: exceeds MaxFormatDigits)" {
+		t.Fatal("invalid format", s)
+	}
+	if x.String() != "1e9000000000" {
+		t.Fatal("invalid format", x)
+	}
+
+	_, err = x.Int64()
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestGobRoundTripBeyondGobLimits(t *testing.T) {
+	x, err := ParseReal("1.5e9000000000", DefaultLimits.MaxPrecision+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := encodeReal(t, x)
+
+	_, err = decodeReal(b)
+	if err != ErrExponentRange {
+		t.Fatal("expected exponent error", err)
+	}
+
+	defer func(l Limits) { GobLimits = l }(GobLimits)
+	GobLimits = Limits{}
+	z, err := decodeReal(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if z.String() != x.String() || z.precision != x.precision {
+		t.Fatal("invalid round trip", z)
+	}
+}
+
+func TestGobDecodeHostileStream(t *testing.T) {
+	// a value of a million and one digits, at a huge precision
+	x := NewInt64(1)
+	x.significand = make(limbs, DefaultLimits.MaxDigits/limbDigits+1)
+	for i := range x.significand {
+		x.significand[i] = limbBase - 1
+	}
+	x.exponent = x.ndigits() - 1
+	x.precision = 1 << 40
+
+	z := NewInt64(5)
+	err := gob.NewDecoder(bytes.NewReader(encodeReal(t, x))).Decode(z)
+	if err != ErrTooManyDigits {
+		t.Fatal("expected digits error", err)
+	}
+	if z.String() != "5e0" {
+		t.Fatal("value modified on error", z)
+	}
+
+	x.significand = limbs{1}
+	x.exponent = 0
+	err = gob.NewDecoder(bytes.NewReader(encodeReal(t, x))).Decode(z)
+	if err != ErrPrecisionRange {
+		t.Fatal("expected precision error", err)
+	}
+}
+
+func TestGobDecodeRounds(t *testing.T) {
+	x, err := ParseReal("123456", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x.precision = 3
+
+	z, err := decodeReal(encodeReal(t, x))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if z.String() != "1.23e5" || z.Precision() != 3 {
+		t.Fatal("invalid decode", z)
+	}
+}
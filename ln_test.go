@@ -112,9 +112,82 @@ func TestLnDecTestLn116(t *testing.T) {
 
 func BenchmarkLn(b *testing.B) {
 	x := new(Real)
-	x.significand = []byte{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9}
+	x.setSignificandDigits([]byte{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9})
 	x.validate()
 	for b.Loop() {
 		x.Ln()
 	}
 }
+
+// ln(1 + 1e-20) == 9.99999999999999999995|0000000000000000000333...e-21, which
+// is very close to a truncation boundary.
+func TestLnHardRounding(t *testing.T) {
+	x, _ := ParseReal("1.00000000000000000001", 21)
+	x.SetMode(ModeZero)
+	z := x.Ln()
+
+	if z.String() != "9.99999999999999999995e-21" {
+		t.Fatal("invalid ln", z)
+	}
+}
+
+// ln(0.9999999999999999999999999901) == -9.900000000000000000000000049005e-27,
+// whose working result is short but not exact.
+func TestLnShortResult(t *testing.T) {
+	x, _ := ParseReal("0.9999999999999999999999999901", DefaultPrecision)
+	if z := x.Ln(); z.String() != "-9.900000000000000000000000049005e-27" {
+		t.Fatal("invalid ln", z)
+	}
+
+	x, _ = ParseReal("4e-151", 3)
+	x.SetMode(ModeZero)
+	if z := x.Log1p(); z.String() != "3.99e-151" {
+		t.Fatal("invalid log1p", z)
+	}
+}
+
+func TestLog1p(t *testing.T) {
+	tests := []struct {
+		x    string
+		want string
+	}{
+		{"1.234e-20", "1.23399999999999999999238622e-20"},
+		{"-1e-10", "-1.000000000050000000003333333333583e-10"},
+		{"-0.5", "-6.931471805599453094172321214581766e-1"},
+		{"3", "1.386294361119890618834464242916353e0"},
+		{"1e-1000", "1e-1000"},
+		{"-1", "-∞"},
+		{"-2", "NaN"},
+	}
+
+	for _, tt := range tests {
+		x, _ := ParseReal(tt.x, DefaultPrecision)
+		z := x.Log1p()
+		if z.String() != tt.want {
+			t.Fatal("invalid log1p", tt.x, z)
+		}
+	}
+}
+
+// Near zero, the result is just above or below x, which decides the
+// truncated result.
+func TestLog1pTiny(t *testing.T) {
+	tests := []struct {
+		x    string
+		mode int
+		want string
+	}{
+		{"1e-40", ModeZero, "9.999999999999999999999999999999999e-41"},
+		{"1e-40", ModeNearest, "1e-40"},
+		{"-1e-40", ModeZero, "-1e-40"},
+		{"-1e-40", ModeNearest, "-1e-40"},
+	}
+
+	for _, tt := range tests {
+		x, _ := ParseReal(tt.x, DefaultPrecision)
+		x.SetMode(tt.mode)
+		if z := x.Log1p(); z.String() != tt.want {
+			t.Fatal("invalid log1p", tt.x, tt.mode, z)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import "math"
+
+// Return the base 10 logarithm (log₁₀) of x. The result is correctly rounded,
+// and exact when x is an exact power of ten.
+func (x *Real) Log10() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		z := x.working(w).log10()
+		return z, int(w) - internalPrecisionBuffer - digits(x.exponent) + min(z.exponent, 0)
+	})
+}
+
+func (x *Real) log10() *Real {
+	if x.negative || x.IsInf() || x.IsNaN() || x.IsZero() {
+		return x.ln()
+	}
+
+	// Powers of ten only need the exponent.
+	z := initFrom(x)
+	z.SetInt64(int64(x.exponent))
+	if x.ndigits() == 1 && x.digit(0) == 1 {
+		return z
+	}
+
+	// log₁₀(x) == e + ln(s)/ln(10) for x == s×10ᵉ, which keeps the
+	// logarithm of the exponent exact.
+	xscaled := x.Copy()
+	xscaled.exponent = 0
+	return z.Add(xscaled.ln().div(ln10(x)))
+}
+
+// Return the base 2 logarithm (log₂) of x. The result is correctly rounded,
+// and exact when x is an exact power of two.
+func (x *Real) Log2() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		z := x.working(w).log2()
+		return z, int(w) - internalPrecisionBuffer - digits(x.exponent) + min(z.exponent, 0)
+	})
+}
+
+func (x *Real) log2() *Real {
+	if x.negative || x.IsInf() || x.IsNaN() || x.IsZero() {
+		return x.ln()
+	}
+
+	if k, ok := x.powerOfTwo(); ok {
+		z := initFrom(x)
+		z.SetInt64(int64(k))
+		return z
+	}
+
+	return x.ln().div(ln2(x))
+}
+
+// Returns k and true if x == 2ᵏ exactly.
+func (x *Real) powerOfTwo() (int, bool) {
+	// estimate k from the leading digits and the exponent, then check
+	// the candidate exactly
+	xscaled := x.Copy()
+	xscaled.exponent = 0
+	xscaled.SetPrecision(float64MinimumDecimalPrecision)
+	f, err := xscaled.Float64()
+	if err != nil {
+		return 0, false
+	}
+	k := math.Round(math.Log2(f) + float64(x.exponent)*math.Log2(10))
+	if math.Abs(k) > 1<<20 {
+		return 0, false
+	}
+
+	p := exactPowerOfTwo(x, int(k))
+	if p == nil {
+		return 0, false
+	}
+	return int(k), p.Compare(x) == 0
+}
+
+// Return 2ᵏ exactly, or nil if it would have more digits than x. Negative
+// powers are computed as 5⁻ᵏ×10ᵏ so they are also exact.
+func exactPowerOfTwo(x *Real, k int) *Real {
+	// 2ᵏ has about 0.3k digits and 2⁻ᵏ about 0.7k, so anything larger
+	// can't match x
+	if (k >= 0 && uint(k)*3/10 > uint(x.ndigits())) || (k < 0 && uint(-k)*7/10 > uint(x.ndigits())+1) {
+		return nil
+	}
+
+	b := initFrom(x)
+	b.precision = uint(x.ndigits()) + 2
+	if k >= 0 {
+		b.SetUint64(2)
+		return b.ipow(k)
+	}
+	b.SetUint64(5)
+	z := b.ipow(-k)
+	z.exponent += k
+	return z
+}
+
+// Return the base b logarithm of x. The result is correctly rounded, and
+// exact when x is an integer power of b. The result is NaN if b is not
+// positive or b == 1.
+func (x *Real) Log(b *Real) *Real {
+	b.validate()
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		x2 := x.working(w)
+		b2 := b.working(umax(w, b.precision))
+		lnb := b2.ln()
+		z, exact := x2.log(b2, lnb)
+		if exact {
+			return z, exactDigits
+		}
+		r := int(w) - internalPrecisionBuffer - digits(x.exponent) - digits(b.exponent) + min(z.exponent, 0)
+		if lnb.form == FormReal && !lnb.IsZero() {
+			r += min(lnb.exponent, 0)
+		}
+		return z, r
+	})
+}
+
+// Return the base b logarithm of x, where lnb is ln(b), and whether it is
+// exact.
+func (x *Real) log(b, lnb *Real) (*Real, bool) {
+	z := initFrom(x)
+	if b.IsNaN() || b.IsInf() || b.negative || b.IsZero() || lnb.IsZero() {
+		z.form = FormNaN
+		return z, true
+	}
+
+	if b.Compare(NewUint64(10)) == 0 {
+		return x.log10(), false
+	} else if b.Compare(NewUint64(2)) == 0 {
+		return x.log2(), false
+	}
+
+	if x.form == FormReal && !x.negative && !x.IsZero() {
+		if k, ok := x.powerOf(b); ok {
+			z.SetInt64(int64(k))
+			return z, true
+		}
+	}
+	return x.ln().div(lnb), false
+}
+
+// Returns k and true if x == bᵏ exactly, for positive x and b, with b ≠ 1.
+func (x *Real) powerOf(b *Real) (int, bool) {
+	// estimate k from the leading digits and the exponents, then check
+	// the candidate exactly
+	lx, lb := x.log10Estimate(), b.log10Estimate()
+	if math.IsNaN(lx) || math.IsNaN(lb) || lb == 0 {
+		return 0, false
+	}
+	k := math.Round(lx / lb)
+	if math.Abs(k) > 1<<20 {
+		return 0, false
+	}
+	n := int(math.Abs(k))
+
+	// A significand of d digits other than 1 has an nth power of at least
+	// 0.3n digits, and of (d-1)n+1 digits for d > 1, since it has no
+	// trailing zeros. Powers with many more digits than x can't match it.
+	d := b.ndigits()
+	if (d > 1 || b.digit(0) != 1) && d*n > 4*(x.ndigits()+1) {
+		return 0, false
+	}
+
+	p := b.working(uint(d*n) + 1).ipow(n)
+	if k < 0 {
+		// x·b⁻ᵏ == 1
+		p = mulExact(x, p)
+		return int(k), p.Compare(NewUint64(1)) == 0
+	}
+	return int(k), p.Compare(x) == 0
+}
+
+// Return an estimate of log₁₀(x) for positive x, or NaN if x can't be
+// estimated.
+func (x *Real) log10Estimate() float64 {
+	xscaled := x.Copy()
+	xscaled.exponent = 0
+	xscaled.SetPrecision(float64MinimumDecimalPrecision)
+	f, err := xscaled.Float64()
+	if err != nil {
+		return math.NaN()
+	}
+	return math.Log10(f) + float64(x.exponent)
+}
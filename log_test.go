@@ -0,0 +1,141 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import "testing"
+
+func TestLog10(t *testing.T) {
+	x := NewUint64(2)
+	z := x.Log10()
+
+	if z.String() != "3.01029995663981195213738894724493e-1" {
+		t.Fatal("invalid log10", z)
+	}
+}
+
+func TestLog10Exact(t *testing.T) {
+	x := NewUint64(1000)
+	z := x.Log10()
+
+	if z.String() != "3e0" {
+		t.Fatal("invalid log10", z)
+	}
+
+	x, _ = ParseReal("1e-300", DefaultPrecision)
+	z = x.Log10()
+
+	if z.String() != "-3e2" {
+		t.Fatal("invalid log10", z)
+	}
+}
+
+func TestLog10NearOne(t *testing.T) {
+	x, _ := ParseReal("0.999", DefaultPrecision)
+	z := x.Log10()
+
+	if z.String() != "-4.345117740176913064656006955246244e-4" {
+		t.Fatal("invalid log10", z)
+	}
+}
+
+func TestLog2(t *testing.T) {
+	x := NewUint64(10)
+	z := x.Log2()
+
+	if z.String() != "3.32192809488736234787031942948939e0" {
+		t.Fatal("invalid log2", z)
+	}
+}
+
+func TestLog2Exact(t *testing.T) {
+	x := NewUint64(1 << 40)
+	z := x.Log2()
+
+	if z.String() != "4e1" {
+		t.Fatal("invalid log2", z)
+	}
+
+	x, _ = ParseReal("0.125", DefaultPrecision)
+	z = x.Log2()
+
+	if z.String() != "-3e0" {
+		t.Fatal("invalid log2", z)
+	}
+}
+
+func TestLog(t *testing.T) {
+	x := NewUint64(100)
+	z := x.Log(NewUint64(7))
+
+	if z.String() != "2.366589324909876653635857123293718e0" {
+		t.Fatal("invalid log", z)
+	}
+
+	z = NewUint64(81).Log(NewUint64(3))
+	if z.String() != "4e0" {
+		t.Fatal("invalid log", z)
+	}
+
+	z = x.Log(NewUint64(10))
+	if z.String() != "2e0" {
+		t.Fatal("invalid log", z)
+	}
+}
+
+func TestLogInvalidBase(t *testing.T) {
+	x := NewUint64(100)
+
+	for _, b := range []*Real{NewInt64(1), NewInt64(0), NewInt64(-2)} {
+		z := x.Log(b)
+		if !z.IsNaN() {
+			t.Fatal("invalid log", b, z)
+		}
+	}
+}
+
+func TestLogForms(t *testing.T) {
+	z := NewInt64(-1).Log10()
+	if !z.IsNaN() {
+		t.Fatal("invalid log10", z)
+	}
+
+	z = new(Real).Log2()
+	if z.String() != "-∞" {
+		t.Fatal("invalid log2", z)
+	}
+}
+
+func TestLogExactDirected(t *testing.T) {
+	td := []struct {
+		x, b, want string
+	}{
+		{"27", "3", "3e0"},
+		{"1024", "4", "5e0"},
+		{"0.008", "0.2", "3e0"},
+		{"0.0625", "4", "-2e0"},
+		{"16", "0.5", "-4e0"},
+		{"1e-6", "100", "-3e0"},
+		{"1", "7", "0"},
+		{"2.25", "1.5", "2e0"},
+	}
+	for _, v := range td {
+		for _, m := range []int{ModeZero, ModeNearestEven} {
+			x, _ := ParseReal(v.x, DefaultPrecision)
+			b, _ := ParseReal(v.b, DefaultPrecision)
+			x.SetMode(m)
+			z := x.Log(b)
+			if z.String() != v.want {
+				t.Fatal("invalid log", v.x, v.b, m, z)
+			}
+		}
+	}
+
+	// inexact results still round toward zero
+	x := NewUint64(26)
+	x.SetMode(ModeZero)
+	if z := x.Log(NewUint64(3)); z.String() != "2.965647273044250130479129521587289e0" {
+		t.Fatal("invalid log", z)
+	}
+}
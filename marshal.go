@@ -9,7 +9,9 @@ import (
 	"encoding/gob"
 )
 
-// GobEncode implements the [encoding/gob.GobEncoder] interface.
+// GobEncode implements the [encoding/gob.GobEncoder] interface. The
+// significand is encoded as its decimal digits, one per byte, independent of
+// the internal representation.
 func (x *Real) GobEncode() ([]byte, error) {
 	w := bytes.Buffer{}
 	enc := gob.NewEncoder(&w)
@@ -25,7 +27,7 @@ func (x *Real) GobEncode() ([]byte, error) {
 		if err != nil {
 			return nil, err
 		}
-		err = enc.Encode(x.significand)
+		err = enc.Encode(x.significandDigits())
 		if err != nil {
 			return nil, err
 		}
@@ -55,42 +57,71 @@ func (x *Real) GobEncode() ([]byte, error) {
 
 }
 
-// GobDecode implements the [encoding/gob.GobDecoder] interface.
+// GobDecode implements the [encoding/gob.GobDecoder] interface. The decoded
+// value is checked against GobLimits, and rounded to its precision. To decode
+// values beyond GobLimits, change it or use GobDecodeLimits.
 func (x *Real) GobDecode(b []byte) error {
+	return x.GobDecodeLimits(b, GobLimits)
+}
+
+// GobDecodeLimits is the same as GobDecode, but checks the decoded value
+// against the given limits. If the payload is malformed or exceeds the limits,
+// an error is returned and x is left unmodified.
+func (x *Real) GobDecodeLimits(b []byte, l Limits) error {
 	r := bytes.NewReader(b)
 	dec := gob.NewDecoder(r)
 
+	var y Real
+
 	var hasS bool
+	var digits []byte
 	err := dec.Decode(&hasS)
 	if err != nil {
 		return err
 	}
 	if hasS {
-		err = dec.Decode(&x.significand)
+		err = dec.Decode(&digits)
 		if err != nil {
 			return err
 		}
 	}
-	err = dec.Decode(&x.negative)
+	err = dec.Decode(&y.negative)
 	if err != nil {
 		return err
 	}
-	err = dec.Decode(&x.exponent)
+	err = dec.Decode(&y.exponent)
 	if err != nil {
 		return err
 	}
-	err = dec.Decode(&x.precision)
+	err = dec.Decode(&y.precision)
 	if err != nil {
 		return err
 	}
-	err = dec.Decode(&x.form)
+	err = dec.Decode(&y.form)
 	if err != nil {
 		return err
 	}
-	err = dec.Decode(&x.mode)
+	err = dec.Decode(&y.mode)
+	if err != nil {
+		return err
+	}
+
+	err = l.check(&y, digits)
 	if err != nil {
 		return err
 	}
 
+	// normalize in case the payload was not produced by GobEncode
+	if hasS {
+		y.setSignificandDigits(digits)
+		if err := l.checkExponent(y.exponent); err != nil {
+			return err
+		}
+	}
+	if y.form == FormReal {
+		y.round()
+	}
+
+	*x = y
 	return nil
 }
@@ -17,6 +17,16 @@ func (x *Real) Mul(y *Real) *Real {
 	return z
 }
 
+// Return the product of x and the int64 y. The result has the precision and
+// rounding mode of x. Unlike x.Mul(NewInt64(y)), no temporary Real is
+// allocated for y.
+func (x *Real) MulInt64(y int64) *Real {
+	x.validate()
+	var buf [3]uint32
+	yr := int64Operand(x, y, &buf)
+	return x.Mul(&yr)
+}
+
 func (x *Real) mul(y *Real) *Real {
 	z := initFrom2(x, y)
 
@@ -44,24 +54,9 @@ func (x *Real) mul(y *Real) *Real {
 		return z
 	}
 
-	for i := len(x.significand) - 1; i >= 0; i-- {
-		p := make([]byte, len(y.significand)+1)
-		for j := len(y.significand) - 1; j >= 0; j-- {
-			p[j+1] += x.significand[i] * y.significand[j]
-			if p[j+1] >= 10 {
-				p[j] = p[j+1] / 10
-				p[j+1] = p[j+1] % 10
-			}
-		}
-		zr := initFrom(z)
-		zr.exponent = 1 - i
-		zr.significand = p
-		zr.round()
-		zn := z.Add(zr)
-		z = zn
-	}
-
-	z.exponent += x.exponent + y.exponent
+	// The product of the significands is exact, and is rounded once.
+	z.significand = mulLimbs(x.significand, y.significand)
+	z.exponent = x.exponent - x.ndigits() + y.exponent - y.ndigits() + z.ndigits() + 1
 	if x.negative != y.negative {
 		z.negative = true
 	}
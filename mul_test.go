@@ -4,7 +4,11 @@
 
 package number
 
-import "testing"
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
 
 func TestMul1(t *testing.T) {
 	x := NewInt64(5)
@@ -59,10 +63,10 @@ func TestMul5(t *testing.T) {
 
 func TestMul6(t *testing.T) {
 	x := new(Real)
-	x.significand = []byte{8, 1, 0, 3, 7, 2, 7, 7, 1, 4, 7, 4, 8, 7, 8, 4, 0, 6}
+	x.setSignificandDigits([]byte{8, 1, 0, 3, 7, 2, 7, 7, 1, 4, 7, 4, 8, 7, 8, 4, 0, 6})
 	x.exponent = -1
 	y := new(Real)
-	y.significand = []byte{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4, 6, 9, 9, 6}
+	y.setSignificandDigits([]byte{1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4, 6, 9, 9, 6})
 
 	z := x.Mul(y)
 	if z.String() != "8.103727714748784440842787682333856e-1" {
@@ -130,11 +134,51 @@ func TestMulZero(t *testing.T) {
 func BenchmarkMul(b *testing.B) {
 	x := new(Real)
 	y := new(Real)
-	x.significand = []byte{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9}
-	y.significand = []byte{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9}
+	x.setSignificandDigits([]byte{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9})
+	y.setSignificandDigits([]byte{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9})
 	x.validate()
 	y.validate()
 	for b.Loop() {
 		x.Mul(y)
 	}
 }
+
+func TestMulInt64(t *testing.T) {
+	x, _ := ParseReal("1.5", DefaultPrecision)
+
+	z := x.MulInt64(-3)
+	if z.String() != "-4.5e0" {
+		t.Fatal("invalid mul", z)
+	}
+}
+
+func TestMulLarge(t *testing.T) {
+	// (1 - 10^-n)² = 1 - 2·10^-n + 10^-2n
+	for _, n := range []int{100, 1000, 10000, 100000} {
+		x, err := ParseReal("0."+strings.Repeat("9", n), uint(2*n))
+		if err != nil {
+			t.Fatal(err)
+		}
+		z := x.Mul(x)
+		want := strings.Repeat("9", n-1) + "8" + strings.Repeat("0", n-1) + "1"
+		var got strings.Builder
+		for _, d := range z.significandDigits() {
+			got.WriteByte('0' + d)
+		}
+		if got.String() != want || z.exponent != -1 {
+			t.Fatal("invalid mul", n)
+		}
+	}
+}
+
+func BenchmarkMulLarge(b *testing.B) {
+	for _, n := range []int{1000, 10000, 100000} {
+		x, _ := ParseReal("0."+strings.Repeat("3", n), uint(n))
+		y, _ := ParseReal("0."+strings.Repeat("7", n), uint(n))
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			for b.Loop() {
+				x.Mul(y)
+			}
+		})
+	}
+}
@@ -0,0 +1,338 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import (
+	"math/bits"
+	"slices"
+)
+
+// The sizes of the shorter operand, in limbs, at which multiplication switches
+// to a faster algorithm. They were tuned by benchmarking balanced products.
+const (
+	karatsubaThreshold = 48  // 432 digits
+	toom3Threshold     = 192 // 1728 digits
+	nttThreshold       = 640 // 5760 digits
+)
+
+// Return x·y. Small operands use the schoolbook method, and larger ones
+// Karatsuba, Toom-3, or a number-theoretic transform, by the size of the
+// shorter operand.
+func mulLimbs(x, y limbs) limbs {
+	if len(x) < len(y) {
+		x, y = y, x
+	}
+	switch {
+	case len(y) == 0:
+		return nil
+	case len(y) < karatsubaThreshold:
+		return mulBasic(x, y)
+	case len(x) >= 2*len(y):
+		return mulUnbalanced(x, y)
+	case len(y) < toom3Threshold:
+		return mulKaratsuba(x, y)
+	case len(y) < nttThreshold || len(y) >= nttMaxLimbs:
+		return mulToom3(x, y)
+	}
+	return mulNTT(x, y)
+}
+
+// Return x·y by the schoolbook method.
+func mulBasic(x, y limbs) limbs {
+	z := make(limbs, len(x)+len(y))
+	for i, v := range x {
+		if v == 0 {
+			continue
+		}
+		// each step is below limbBase², so the carry stays below
+		// limbBase
+		var carry uint64
+		for j, w := range y {
+			t := uint64(v)*uint64(w) + uint64(z[i+j]) + carry
+			z[i+j] = uint32(t % limbBase)
+			carry = t / limbBase
+		}
+		z[i+len(y)] = uint32(carry)
+	}
+	return z.norm()
+}
+
+// Return x·y for len(x) ≥ 2·len(y), by multiplying y with pieces of x the
+// size of y, so that each product is balanced.
+func mulUnbalanced(x, y limbs) limbs {
+	z := make(limbs, len(x)+len(y))
+	for i := 0; i < len(x); i += len(y) {
+		z.addAt(mulLimbs(x[i:min(i+len(y), len(x))].norm(), y), i)
+	}
+	return z.norm()
+}
+
+// Return the pieces of x of m limbs, least significant first, with the rest in
+// the last piece.
+func (x limbs) split(m, n int) []limbs {
+	p := make([]limbs, n)
+	for i := range p {
+		lo, hi := min(i*m, len(x)), min((i+1)*m, len(x))
+		if i == n-1 {
+			hi = len(x)
+		}
+		p[i] = x[lo:hi].norm()
+	}
+	return p
+}
+
+// Return x·y for len(x) ≥ len(y) by Karatsuba's method. With x = x₁B + x₀ and
+// y = y₁B + y₀,
+//
+//	x·y = x₁y₁B² + ((x₀+x₁)(y₀+y₁) - x₀y₀ - x₁y₁)B + x₀y₀
+//
+// which takes three half-size products instead of four.
+func mulKaratsuba(x, y limbs) limbs {
+	m := (len(x) + 1) / 2
+	xs, ys := x.split(m, 2), y.split(m, 2)
+	z0 := mulLimbs(xs[0], ys[0])
+	z2 := mulLimbs(xs[1], ys[1])
+	z1 := mulLimbs(addLimbs(xs[0], xs[1]), addLimbs(ys[0], ys[1]))
+	z1 = subLimbs(subLimbs(z1, z0), z2)
+
+	z := make(limbs, len(x)+len(y)+1)
+	copy(z, z0)
+	z.addAt(z1, m)
+	z.addAt(z2, 2*m)
+	return z.norm()
+}
+
+// A signed integer, for the interpolation in Toom-3.
+type signedLimbs struct {
+	negative bool
+	abs      limbs
+}
+
+func (x signedLimbs) add(y signedLimbs) signedLimbs {
+	switch {
+	case x.negative == y.negative:
+		return signedLimbs{x.negative, addLimbs(x.abs, y.abs)}
+	case x.abs.cmp(y.abs) >= 0:
+		return signedLimbs{x.negative, subLimbs(x.abs, y.abs)}
+	}
+	return signedLimbs{y.negative, subLimbs(y.abs, x.abs)}
+}
+
+func (x signedLimbs) sub(y signedLimbs) signedLimbs {
+	return x.add(signedLimbs{!y.negative, y.abs})
+}
+
+func (x signedLimbs) mul(y signedLimbs) signedLimbs {
+	return signedLimbs{x.negative != y.negative, mulLimbs(x.abs, y.abs)}
+}
+
+// Return x/d, where d divides x.
+func (x signedLimbs) divExact(d uint64) signedLimbs {
+	q, _ := x.abs.divUint64(d)
+	return signedLimbs{x.negative, q}
+}
+
+// Return the values of x₂t² + x₁t + x₀ at t = 0, 1, -1, -2, and ∞.
+func toom3Evaluate(x []limbs) [5]signedLimbs {
+	x0, x1, x2 := signedLimbs{abs: x[0]}, signedLimbs{abs: x[1]}, signedLimbs{abs: x[2]}
+	p := x0.add(x2)
+	m1 := p.sub(x1)
+	m2 := m1.add(x2)
+	m2 = m2.add(m2).sub(x0)
+	return [5]signedLimbs{x0, p.add(x1), m1, m2, x2}
+}
+
+// Return x·y for len(x) ≥ len(y) by Toom-3, which splits each operand into
+// three pieces, evaluates them as polynomials at five points, and interpolates
+// the product from five third-size products. The interpolation follows
+// Bodrato, "Towards Optimal Toom-Cook Multiplication for Univariate and
+// Multivariate Polynomials in Characteristic 2 and 0", 2007.
+func mulToom3(x, y limbs) limbs {
+	m := (len(x) + 2) / 3
+	px, py := toom3Evaluate(x.split(m, 3)), toom3Evaluate(y.split(m, 3))
+	var r [5]signedLimbs
+	for i := range r {
+		r[i] = px[i].mul(py[i])
+	}
+	r0, r1, rm1, rm2, rinf := r[0], r[1], r[2], r[3], r[4]
+
+	r3 := rm2.sub(r1).divExact(3)
+	r1 = r1.sub(rm1).divExact(2)
+	r2 := rm1.sub(r0)
+	r3 = r2.sub(r3).divExact(2).add(rinf).add(rinf)
+	r2 = r2.add(r1).sub(rinf)
+	r1 = r1.sub(r3)
+
+	// the coefficients of the product are nonnegative
+	z := make(limbs, len(x)+len(y)+1)
+	for i, c := range []signedLimbs{r0, r1, r2, r3, rinf} {
+		z.addAt(c.abs, i*m)
+	}
+	return z.norm()
+}
+
+// The transform is over the integers modulo the prime 2⁶⁴ - 2³² + 1, which has
+// roots of unity of every order 2ᵏ up to 2³², and 7 generates its
+// multiplicative group.
+const (
+	nttPrime     = 0xffffffff00000001
+	nttGenerator = 7
+	nttEpsilon   = 1<<32 - 1 // 2⁶⁴ mod nttPrime
+)
+
+// Each pair of limbs is split into three digits of base 10⁶ for the
+// transform. A coefficient of the product is a sum of at most n products of
+// digits, where n is the number of digits of the shorter operand, so it is
+// below n·10¹² and exact in the field for n < 1.8·10⁷. That holds when the
+// shorter operand has fewer than nttMaxLimbs limbs.
+const (
+	nttDigitBase = 1_000_000
+	nttMaxLimbs  = 10_000_000
+)
+
+// The arithmetic in the field avoids branches on the values, which are
+// unpredictable.
+
+func nttAdd(a, b uint64) uint64 {
+	s, c := bits.Add64(a, b, 0)
+	d, borrow := bits.Sub64(s, nttPrime, 0)
+	if c|(borrow^1) != 0 {
+		s = d
+	}
+	return s
+}
+
+func nttSub(a, b uint64) uint64 {
+	d, borrow := bits.Sub64(a, b, 0)
+	return d + nttPrime&-borrow
+}
+
+func nttMul(a, b uint64) uint64 {
+	// With hi = h₁·2³² + h₀, and 2⁶⁴ ≡ 2³² - 1 and 2⁹⁶ ≡ -1,
+	// hi·2⁶⁴ + lo ≡ lo - h₁ + h₀·(2³² - 1).
+	hi, lo := bits.Mul64(a, b)
+	t, borrow := bits.Sub64(lo, hi>>32, 0)
+	t -= nttEpsilon & -borrow
+	t, c := bits.Add64(t, (hi&nttEpsilon)*nttEpsilon, 0)
+	t += nttEpsilon & -c
+	d, borrow := bits.Sub64(t, nttPrime, 0)
+	if borrow == 0 {
+		t = d
+	}
+	return t
+}
+
+func nttPow(a, e uint64) uint64 {
+	z := uint64(1)
+	for ; e != 0; e >>= 1 {
+		if e&1 != 0 {
+			z = nttMul(z, a)
+		}
+		a = nttMul(a, a)
+	}
+	return z
+}
+
+// Return the powers ωᵏ for k < n/2 of a root of unity ω of order n.
+func nttRoots(n int) []uint64 {
+	w := make([]uint64, n/2)
+	r := nttPow(nttGenerator, (nttPrime-1)/uint64(n))
+	w[0] = 1
+	for k := 1; k < len(w); k++ {
+		w[k] = nttMul(w[k-1], r)
+	}
+	return w
+}
+
+// Transform a in place, where len(a) is a power of two, with the powers w of a
+// root of unity of order len(a).
+func ntt(a, w []uint64) {
+	n := len(a)
+	for i, j := 1, 0; i < n; i++ {
+		b := n >> 1
+		for ; j&b != 0; b >>= 1 {
+			j ^= b
+		}
+		j ^= b
+		if i < j {
+			a[i], a[j] = a[j], a[i]
+		}
+	}
+
+	for size := 2; size <= n; size <<= 1 {
+		h, step := size/2, n/size
+		for i := 0; i < n; i += size {
+			lo, hi := a[i:i+h], a[i+h:i+size]
+			for k := range lo {
+				u, v := lo[k], nttMul(hi[k], w[k*step])
+				lo[k] = nttAdd(u, v)
+				hi[k] = nttSub(u, v)
+			}
+		}
+	}
+}
+
+// Return the base 10⁶ digits of x, least significant first, in a slice of
+// length n.
+func (x limbs) nttDigits(n int) []uint64 {
+	a := make([]uint64, n)
+	for i := 0; i < len(x); i += 2 {
+		lo, hi := x[i], uint32(0)
+		if i+1 < len(x) {
+			hi = x[i+1]
+		}
+		j := i / 2 * 3
+		a[j] = uint64(lo % nttDigitBase)
+		a[j+1] = uint64(lo/nttDigitBase + hi%1000*1000)
+		a[j+2] = uint64(hi / 1000)
+	}
+	return a
+}
+
+// Return x·y by a number-theoretic transform, the discrete Fourier transform
+// over a finite field. The digits of the product are the cyclic convolution of
+// the digits of x and y, which is the pointwise product of their transforms.
+func mulNTT(x, y limbs) limbs {
+	m := (len(x) + len(y) + 3) / 2 // pairs of limbs
+	n := 1
+	for n < 3*m {
+		n <<= 1
+	}
+	w := nttRoots(n)
+	a := x.nttDigits(n)
+	ntt(a, w)
+	if len(x) == len(y) && &x[0] == &y[0] {
+		for i := range a {
+			a[i] = nttMul(a[i], a[i])
+		}
+	} else {
+		b := y.nttDigits(n)
+		ntt(b, w)
+		for i := range a {
+			a[i] = nttMul(a[i], b[i])
+		}
+	}
+
+	// The inverse transform is the transform with the indices after the
+	// first reversed, divided by n.
+	ntt(a, w)
+	slices.Reverse(a[1:])
+	s := nttPow(uint64(n), nttPrime-2)
+
+	// carry the coefficients into base 10⁶ digits, then pack the limbs
+	z := make(limbs, 2*m)
+	var d [3]uint32
+	var carry uint64
+	for i := range m {
+		for j := range d {
+			t := nttMul(a[3*i+j], s) + carry
+			d[j] = uint32(t % nttDigitBase)
+			carry = t / nttDigitBase
+		}
+		z[2*i] = d[0] + d[1]%1000*nttDigitBase
+		z[2*i+1] = d[1]/1000 + d[2]*1000
+	}
+	return z.norm()
+}
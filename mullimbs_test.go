@@ -0,0 +1,87 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"testing"
+)
+
+// Return n random limbs with a nonzero leading limb.
+func randomLimbs(r *rand.Rand, n int) limbs {
+	x := make(limbs, n)
+	for i := range x {
+		x[i] = uint32(r.IntN(limbBase))
+	}
+	x[n-1] = max(x[n-1], 1)
+	return x
+}
+
+func TestMulLimbsAlgorithms(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	td := []struct {
+		name string
+		f    func(x, y limbs) limbs
+	}{
+		{"karatsuba", mulKaratsuba},
+		{"toom3", mulToom3},
+		{"ntt", mulNTT},
+		{"mulLimbs", mulLimbs},
+	}
+	for _, n := range []int{1, 2, 3, 7, karatsubaThreshold - 1, karatsubaThreshold, toom3Threshold - 1, toom3Threshold, 333, nttThreshold - 1, nttThreshold, 1500} {
+		for _, m := range []int{n, n/2 + 1, (n + 2) / 3} {
+			x, y := randomLimbs(r, n), randomLimbs(r, m)
+			want := mulBasic(x, y)
+			for _, v := range td {
+				if z := v.f(x, y); z.cmp(want) != 0 {
+					t.Fatal("invalid", v.name, n, m)
+				}
+			}
+		}
+	}
+}
+
+func TestMulLimbsAllNines(t *testing.T) {
+	// (10^9n - 1)² = 10^18n - 2·10^9n + 1 maximizes every carry
+	for _, n := range []int{50, 200, 2000} {
+		x := make(limbs, n)
+		for i := range x {
+			x[i] = limbBase - 1
+		}
+		z := mulLimbs(x, x)
+		if len(z) != 2*n || z[0] != 1 || z[n] != limbBase-2 || z[2*n-1] != limbBase-1 {
+			t.Fatal("invalid mulLimbs", n)
+		}
+		for i := 1; i < 2*n; i++ {
+			if i != n && (i < n) != (z[i] == 0) {
+				t.Fatal("invalid mulLimbs", n, i, z[i])
+			}
+		}
+	}
+}
+
+func BenchmarkMulLimbs(b *testing.B) {
+	r := rand.New(rand.NewPCG(1, 2))
+	td := []struct {
+		name string
+		f    func(x, y limbs) limbs
+	}{
+		{"basic", mulBasic},
+		{"karatsuba", mulKaratsuba},
+		{"toom3", mulToom3},
+		{"ntt", mulNTT},
+	}
+	for _, n := range []int{karatsubaThreshold, toom3Threshold, nttThreshold} {
+		x, y := randomLimbs(r, n), randomLimbs(r, n)
+		for _, v := range td {
+			b.Run(fmt.Sprintf("%v/%v", v.name, n), func(b *testing.B) {
+				for b.Loop() {
+					v.f(x, y)
+				}
+			})
+		}
+	}
+}
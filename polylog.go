@@ -0,0 +1,389 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import "fmt"
+
+// MaxPolylogIterations is the maximum number of terms in the series used for
+// the polylogarithm. If this limit is reached, the function will panic.
+const MaxPolylogIterations = 100000
+
+// Return the polylogarithm of integer order s,
+//
+//	Liₛ(x) = Σ x^k/k^s, for k ≥ 1
+//
+// continued to the whole real line. For s ≤ 0 it is a rational function, and
+// for s ≥ 1 and x > 1, where Liₛ is complex, the result is its real part. The
+// result is correctly rounded. Liₛ(1) is ζ(s) for s ≥ 2 and +Inf otherwise,
+// and Liₛ(±Inf) is -Inf for s ≥ 1.
+func (x *Real) Polylog(s int) *Real {
+	switch {
+	case s <= 0:
+		return x.polylogRational(-s)
+	case s == 1 && x.Compare(NewInt64(1)) == 1:
+		// Re Li₁(x) = -ln(x-1)
+		return x.correctlyRounded(func(w uint) (*Real, int) {
+			d := x.working(w).SubInt64(1)
+			z := d.ln()
+			z.negate()
+			return z, int(w) - internalPrecisionBuffer - digits(d.exponent) + min(z.exponent, 0)
+		})
+	case s == 1:
+		// Li₁(x) = -ln(1-x)
+		n := x.Copy()
+		n.negate()
+		z := n.Log1p()
+		z.negate()
+		return z
+	case s == 2:
+		return x.Dilog()
+	}
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		z, loss := x.working(w).polylog(s)
+		return z, int(w) - internalPrecisionBuffer - 3 - loss
+	})
+}
+
+// Return Liₛ(x) for s ≥ 2, along with the number of digits lost to
+// cancellation.
+func (x *Real) polylog(s int) (*Real, int) {
+	z := initFrom(x)
+	one := initFrom(x)
+	one.SetInt64(1)
+	half := initFrom(x)
+	half.SetInt64(5)
+	half.exponent = -1
+
+	c := x.Compare(one)
+	if x.IsNaN() {
+		z.form = FormNaN
+		return z, 0
+	} else if x.IsInf() {
+		z.form = FormInf
+		z.negative = true
+		return z, 0
+	} else if x.IsZero() {
+		return z, 0
+	} else if c == 0 {
+		return zetaInteger(s, x), 0
+	} else if s == 2 {
+		return x.dilog()
+	} else if c == 1 {
+		return x.polylogInverse(s)
+	}
+
+	// The series converges quickly for small x, and for any |x| ≤ 1 once
+	// the order is large.
+	a := x.Abs()
+	switch {
+	case a.Compare(half) <= 0 || (a.Compare(one) <= 0 && 2*s > int(x.precision)):
+		return x.polylogSeries(s), 0
+	case !x.negative:
+		return x.polylogLog(s), 0
+	case a.Compare(one) <= 0:
+		// Liₛ(x) = 2^(1-s)·Liₛ(x²) - Liₛ(-x)
+		two := initFrom(x)
+		two.SetInt64(2)
+		y, _ := x.mul(x).polylog(s)
+		v, _ := a.polylog(s)
+		return y.div(two.ipow(s - 1)).Sub(v), 0
+	}
+
+	// For x < -1, with μ = ln(-x) and η(0) = 1/2, the inversion formula
+	//
+	//	Liₛ(x) = -(-1)^s·Liₛ(1/x) - 2·Σ μ^(s-2k)/(s-2k)!·η(2k), for k in [0, s/2]
+	//
+	// where η(2k) = (1 - 2^(1-2k))·ζ(2k) is the alternating zeta function.
+	z, _ = x.reciprocal().polylog(s)
+	if s%2 == 0 {
+		z.negate()
+	}
+	mu := a.ln()
+	two := initFrom(x)
+	two.SetInt64(2)
+	for k := 0; k <= s/2; k++ {
+		var eta *Real
+		if k == 0 {
+			eta = half
+		} else {
+			eta = one.Sub(two.ipow(1 - 2*k)).mul(zetaInteger(2*k, x))
+		}
+		f := factorialExact(s - 2*k)
+		f.SetPrecision(x.precision)
+		z = z.Sub(mu.ipow(s - 2*k).div(f).mul(eta).mul(two))
+	}
+	return z, 0
+}
+
+// Return the real part of Liₛ(x) for x > 1 and s ≥ 3, along with the number
+// of digits lost to cancellation. With L = ln x, the inversion formula gives
+//
+//	Re Liₛ(x) = -(-1)^s·Liₛ(1/x) - L^s/s! + 2·Σ ζ(2k)·L^(s-2k)/(s-2k)!, for k in [1, s/2]
+func (x *Real) polylogInverse(s int) (*Real, int) {
+	z, _ := x.reciprocal().polylog(s)
+	if s%2 == 0 {
+		z.negate()
+	}
+	l := x.ln()
+	f := factorialExact(s)
+	f.SetPrecision(x.precision)
+	t := l.ipow(s).div(f)
+	top := max(z.exponent, t.exponent)
+	z = z.Sub(t)
+	for k := 1; k <= s/2; k++ {
+		f := factorialExact(s - 2*k)
+		f.SetPrecision(x.precision)
+		t := zetaInteger(2*k, x).mul(l.ipow(s - 2*k)).div(f)
+		t = t.Add(t)
+		top = max(top, t.exponent)
+		z = z.Add(t)
+	}
+	return z, cancellation(top, z)
+}
+
+// Return the number of digits lost when terms with the largest exponent top
+// sum to z. All of them are lost when z is zero.
+func cancellation(top int, z *Real) int {
+	if z.IsZero() {
+		return int(z.precision)
+	}
+	return max(top-z.exponent, 0)
+}
+
+// Return ζ(n) for the integer n ≠ 1 with the precision and rounding mode of x.
+func zetaInteger(n int, x *Real) *Real {
+	z := initFrom(x)
+	z.SetInt64(int64(n))
+	z, _ = z.zeta()
+	return z
+}
+
+// Return Liₛ(x) for |x| ≤ 1 from its defining series.
+func (x *Real) polylogSeries(s int) *Real {
+	z := initFrom(x)
+	xk := initFrom(x)
+	xk.SetInt64(1)
+	k := initFrom(x)
+	for i := uint64(1); ; i++ {
+		if i > MaxPolylogIterations {
+			panic(fmt.Sprintf("failed to converge polylog(%v, %v)", s, x))
+		}
+		xk = xk.mul(x)
+		k.SetUint64(i)
+		t := xk.div(k.ipow(s))
+		if t.exponent < z.exponent-int(x.precision)-1 {
+			return z
+		}
+		z = z.Add(t)
+	}
+}
+
+// Return Liₛ(x) for 1/2 < x < 1 from its series in μ = ln x,
+//
+//	Liₛ(x) = μ^(s-1)/(s-1)!·(Hₛ₋₁ - ln(-μ)) + Σ ζ(s-k)·μ^k/k!, for k ≥ 0, k ≠ s-1
+//
+// with the harmonic number Hₛ₋₁, which converges for |μ| < 2π. For k > s,
+// ζ(s-k) is zero when k-s is even, and otherwise comes from a Bernoulli number.
+func (x *Real) polylogLog(s int) *Real {
+	one := initFrom(x)
+	one.SetInt64(1)
+	mu := x.ln()
+
+	// the term for k = s-1
+	h := initFrom(x)
+	for k := 1; k < s; k++ {
+		h = h.Add(one.divUint64(uint64(k)))
+	}
+	m := mu.Copy()
+	m.negate()
+	f := factorialExact(s - 1)
+	f.SetPrecision(x.precision)
+	z := mu.ipow(s - 1).div(f).mul(h.Sub(m.ln()))
+
+	p := one // μ^k/k!
+	for k := 0; ; k++ {
+		if k > MaxPolylogIterations {
+			panic(fmt.Sprintf("failed to converge polylog(%v, %v)", s, x))
+		}
+		if k > 0 {
+			p = p.mul(mu).divUint64(uint64(k))
+		}
+		if k == s-1 {
+			continue
+		}
+
+		n := s - k
+		if n < 0 && n%2 == 0 {
+			continue
+		}
+		t := zetaInteger(n, x).mul(p)
+		if k > s && t.exponent < z.exponent-int(x.precision)-1 {
+			return z
+		}
+		z = z.Add(t)
+	}
+}
+
+// Return the dilogarithm,
+//
+//	Li₂(x) = -∫ ln(1-t)/t dt, for t in [0, x]
+//
+// The result is correctly rounded. Li₂(1) is π²/6, and for x > 1, where Li₂ is
+// complex, the result is its real part. Li₂(±Inf) is -Inf.
+func (x *Real) Dilog() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		z, loss := x.working(w).dilog()
+		return z, int(w) - internalPrecisionBuffer - 3 - loss
+	})
+}
+
+// Return Li₂(x), along with the number of digits lost to cancellation.
+func (x *Real) dilog() (*Real, int) {
+	z := initFrom(x)
+	one := initFrom(x)
+	one.SetInt64(1)
+	half := initFrom(x)
+	half.SetInt64(5)
+	half.exponent = -1
+
+	c := x.Compare(one)
+	if x.IsNaN() {
+		z.form = FormNaN
+		return z, 0
+	} else if x.IsInf() {
+		z.form = FormInf
+		z.negative = true
+		return z, 0
+	} else if x.IsZero() {
+		return z, 0
+	}
+
+	// π²/6
+	p := pi(x)
+	p = p.mul(p).divUint64(6)
+	if c == 0 {
+		return p, 0
+	}
+
+	switch {
+	case c == 1:
+		// Re Li₂(x) = π²/3 - ½ln²x - Li₂(1/x)
+		l := x.ln()
+		l = l.mul(l).divUint64(2)
+		v, _ := x.reciprocal().dilog()
+		p = p.Add(p)
+		top := max(p.exponent, l.exponent, v.exponent)
+		z = p.Sub(l).Sub(v)
+		return z, cancellation(top, z)
+	case x.Compare(NewInt64(-1)) == -1:
+		// Li₂(x) = -π²/6 - ½ln²(-x) - Li₂(1/x)
+		l := x.Abs().ln()
+		v, _ := x.reciprocal().dilog()
+		z = v.Add(p).Add(l.mul(l).divUint64(2))
+		z.negate()
+		return z, 0
+	case x.negative:
+		// Li₂(x) = -Li₂(x/(x-1)) - ½ln²(1-x), where x/(x-1) is in (0, 1/2]
+		y := x.reflect()
+		l := y.ln()
+		n := x.Abs()
+		z = n.div(y).dilogSeries().Add(l.mul(l).divUint64(2))
+		z.negate()
+		return z, 0
+	case x.Compare(half) == 1:
+		// Li₂(x) = π²/6 - ln x·ln(1-x) - Li₂(1-x)
+		y := x.reflect()
+		return p.Sub(x.ln().mul(y.ln())).Sub(y.dilogSeries()), 0
+	}
+	return x.dilogSeries(), 0
+}
+
+// Return Li₂(x) for 0 < x ≤ 1/2 from the series in u = -ln(1-x),
+//
+//	Li₂(x) = Σ Bₙ·u^(n+1)/(n+1)!, for n ≥ 0
+//
+// which converges for |u| < 2π. Since u ≤ ln 2, each even term adds about two
+// digits.
+func (x *Real) dilogSeries() *Real {
+	n := x.Copy()
+	n.negate()
+	u := n.log1p()
+	u.negate()
+	u2 := u.mul(u)
+
+	// the terms for B₀ and B₁
+	z := u.Sub(u2.divUint64(4))
+	p := u // u^(2k+1)/(2k+1)!
+	for k := 1; ; k++ {
+		if k > MaxPolylogIterations {
+			panic(fmt.Sprintf("failed to converge dilog(%v)", x))
+		}
+		p = p.mul(u2).divUint64(uint64(2 * k * (2*k + 1)))
+		t := bernoulli2k(k, x).mul(p)
+		if t.IsZero() || t.exponent < z.exponent-int(x.precision)-1 {
+			return z
+		}
+		z = z.Add(t)
+	}
+}
+
+// Return Li₋ₙ(x) for n ≥ 0, correctly rounded. It is the rational function
+//
+//	Li₋ₙ(x) = Σ k!·S(n+1, k+1)·x^(k+1)·(1-x)^(n-k) / (1-x)^(n+1), for k in [0, n]
+//
+// with the Stirling numbers of the second kind S, so the numerator and
+// denominator are computed exactly and divided once.
+func (x *Real) polylogRational(n int) *Real {
+	x.validate()
+	z := initFrom(x)
+	switch {
+	case x.IsNaN():
+		z.form = FormNaN
+		return z
+	case x.IsInf():
+		// Li₀(x) = x/(1-x) tends to -1, and the others to 0
+		if n == 0 {
+			z.SetInt64(-1)
+		}
+		return z
+	case x.IsZero():
+		return z
+	case x.Compare(NewInt64(1)) == 0:
+		z.form = FormInf
+		return z
+	}
+
+	// cₖ = k!·S(n+1, k+1), by the recurrence S(m, j) = j·S(m-1, j) + S(m-1, j-1)
+	s := []*Real{NewUint64(1)}
+	for m := 1; m <= n+1; m++ {
+		next := make([]*Real, m+1)
+		next[0] = new(Real)
+		for j := 1; j <= m; j++ {
+			next[j] = s[j-1]
+			if j < m {
+				next[j] = addExact(mulExact(NewUint64(uint64(j)), s[j]), next[j])
+			}
+		}
+		s = next
+	}
+	c := make([]*Real, n+1)
+	f := NewUint64(1)
+	for k := 0; k <= n; k++ {
+		if k > 0 {
+			f = mulExact(f, NewUint64(uint64(k)))
+		}
+		c[k] = mulExact(f, s[k+1])
+	}
+
+	// Horner's rule on the homogeneous polynomial Σ cₖ·x^k·y^(n-k)
+	y := x.reflect()
+	h := c[n]
+	yk := NewUint64(1)
+	for k := n - 1; k >= 0; k-- {
+		yk = mulExact(yk, y)
+		h = addExact(mulExact(h, x), mulExact(c[k], yk))
+	}
+	return x.quotient(mulExact(h, x), mulExact(yk, y))
+}
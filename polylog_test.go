@@ -0,0 +1,117 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestPolylog(t *testing.T) {
+	tests := []struct {
+		s       int
+		x, want string
+	}{
+		{3, "0.3", "3.124001778928926207572816583209336e-1"},
+		{3, "0.7", "7.800639342576615608835690998593831e-1"},
+		{3, "0.99", "1.185832933645036934334943631307684e0"},
+		{3, "1", "1.20205690315959428539973816151145e0"},
+		{3, "-0.8", "-7.343713056344429049181774187651817e-1"},
+		{3, "-1", "-9.015426773696957140498036211335875e-1"},
+		{3, "-2", "-1.668283363966571212046345315887519e0"},
+		{3, "-1e10", "-2.072554598906404169120053041322875e3"},
+		{4, "-0.95", "-9.018562448868572213013420370319144e-1"},
+		{4, "-5", "-4.106467979094970262107350537816408e0"},
+		{7, "0.75", "7.546106525767309947493826442409478e-1"},
+		{7, "-1.5", "-1.483725871445748538480866295622142e0"},
+		{50, "0.9", "9.000000000000007194245209725665656e-1"},
+		{1, "0.5", "6.931471805599453094172321214581766e-1"},
+		{0, "0.5", "1e0"},
+		{-1, "0.3", "6.122448979591836734693877551020408e-1"},
+		{-2, "-2", "7.407407407407407407407407407407407e-2"},
+		{-3, "0.999", "5.988006999e12"},
+		{-5, "3", "6.825e1"},
+		{0, "inf", "-1e0"},
+		{-1, "1", "∞"},
+		{3, "1.5", "2.060877507320280871290564206637761e0"},
+		{3, "2", "2.762071906228924135936640679811043e0"},
+		{3, "3", "3.742122594240731635378529550316408e0"},
+		{4, "100", "1.829964910597773702592151234966881e1"},
+		{5, "10", "1.123904073761129916201071109645389e1"},
+		{7, "1.01", "1.018523688278451199951857881888047e0"},
+		{50, "1.5", "1.500000000000001998401449026510812e0"},
+		{1, "3", "-6.931471805599453094172321214581766e-1"},
+		{1, "2", "0"},
+		{3, "-inf", "-∞"},
+		{3, "inf", "-∞"},
+		{1, "inf", "-∞"},
+	}
+
+	for _, v := range tests {
+		x, err := ParseReal(v.x, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := x.Polylog(v.s); z.String() != v.want {
+			t.Fatal("invalid polylog", v.s, v.x, z)
+		}
+	}
+}
+
+func TestDilog(t *testing.T) {
+	tests := []struct {
+		x, want string
+	}{
+		{"0", "0"},
+		{"1e-30", "1.00000000000000000000000000000025e-30"},
+		{"0.3", "3.26129510075476069530035694174996e-1"},
+		{"0.9", "1.299714723004958725171060494192953e0"},
+		{"1", "1.644934066848226436472415166646025e0"},
+		{"-0.4", "-3.658325775124496279907642196530331e-1"},
+		{"-0.9", "-7.521631792172616203726927134268145e-1"},
+		{"-1", "-8.224670334241132182362075833230126e-1"},
+		{"-3", "-1.939375420766708953077271719177891e0"},
+		{"-100", "-1.223875517731493892173103545886665e1"},
+		{"1.0001", "1.645955052336903182943855006863021e0"},
+		{"1.5", "2.374395270272480200677499763071638e0"},
+		{"2", "2.467401100272339654708622749969038e0"},
+		{"10", "5.363012873578627365501597699378093e-1"},
+		{"12", "1.173506750161741985714073743669265e-1"},
+		{"12.595170369", "1.644111897627743121330996221892219e-10"},
+		{"-inf", "-∞"},
+		{"inf", "-∞"},
+	}
+
+	for _, v := range tests {
+		x, err := ParseReal(v.x, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := x.Dilog(); z.String() != v.want {
+			t.Fatal("invalid dilog", v.x, z)
+		}
+		if z := x.Polylog(2); z.String() != v.want {
+			t.Fatal("invalid polylog", 2, v.x, z)
+		}
+	}
+}
+
+func TestPolylogHighPrecision(t *testing.T) {
+	x, err := ParseReal("0.5", 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if z := fmt.Sprintf("%.60e", x.Polylog(3)); z != "5.37213193608040200940623225594965826670402499340378170689762e-1" {
+		t.Fatal("invalid polylog", z)
+	}
+
+	x, err = ParseReal("-0.6", 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if z := fmt.Sprintf("%.60e", x.Dilog()); z != "-5.28107174044666536598672407090022962998654083195270031114928e-1" {
+		t.Fatal("invalid dilog", z)
+	}
+}
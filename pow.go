@@ -4,17 +4,37 @@
 
 package number
 
-// Return the power of y and base x (x^y).
+// The largest denominator of a fractional power that is computed as an exact
+// root.
+const maxRootDenominator = 15625
+
+// Return the power of y and base x (x^y). The result is correctly rounded.
+// Integer powers are exact when the precision allows. Negative values of x
+// have a real power when y, as a fraction in lowest terms, has an odd
+// denominator, such as (-32)^0.2 == -2.
+//
+// Since y is decimal, that denominator is a power of five, up to 15625. A
+// rounded y such as 1/3 has an even denominator, so (-8)^(1/3) is NaN. Use
+// Cbrt or NthRoot for odd roots of negative numbers.
 func (x *Real) Pow(y *Real) *Real {
-	x.validate()
 	y.validate()
-	x2 := x.Copy()
-	y2 := y.Copy()
-	x2.pip(x.precision)
-	y2.pip(y.precision)
-	z := x2.pow(y2)
-	z.SetPrecision(x.precision)
-	return z
+	if n, ok := y.smallInteger(); ok && x.form == FormReal && !x.IsZero() {
+		return x.correctlyRounded(func(w uint) (*Real, int) {
+			// Every partial product is exact if the result fits in
+			// the working precision.
+			z := x.working(w).ipow(n)
+			if n > 0 && n <= int(w)/x.ndigits() {
+				return z, exactDigits
+			}
+			return z, int(w) - internalPrecisionBuffer - digits(n) - 1
+		})
+	}
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		// x^y == e^(y*ln(x)), so the error of eˣ is scaled by the
+		// magnitude of y*ln(x).
+		z := x.working(w).pow(y.working(umax(w, y.precision)))
+		return z, int(w) - internalPrecisionBuffer - max(y.exponent+1, 0) - digits(x.exponent) - 1
+	})
 }
 
 func (x *Real) pow(y *Real) *Real {
@@ -67,13 +87,33 @@ func (x *Real) pow(y *Real) *Real {
 		z := initFrom2(x, y)
 		z.form = FormNaN
 		return z
+	} else if x.negative && !x.IsInf() && !y.IsInteger() {
+		num, den, ok := y.fraction()
+		if !ok {
+			// even roots of negative numbers are not real
+			z := initFrom2(x, y)
+			z.form = FormNaN
+			return z
+		}
+
+		// (-a)^(n/d) == -(a^(n/d)) for odd n and odd d
+		z := x.Abs().powFraction(y, num, den)
+		if num.significand.digit(0)%2 != 0 {
+			z.negate()
+		}
+		return z
 	} else if x.negative && y.Abs().Compare(NewUint64(1)) == -1 {
 		z := initFrom2(x, y)
 		z.form = FormNaN
 		return z
 	}
 
-	// Positive integer exponents can be calculated faster by decomposing
+	// Integer exponents that fit in an int use binary exponentiation.
+	if n, ok := y.smallInteger(); ok {
+		return x.ipow(n)
+	}
+
+	// Larger integer exponents can be calculated faster by decomposing
 	// the exponent. Additionally it allows for things like -3^2.
 	if y.IsInteger() {
 		if y.negative {
@@ -88,7 +128,7 @@ func (x *Real) pow(y *Real) *Real {
 			} else if y.mod(two).Compare(new(Real)) == 0 {
 				return x.pow(y.div(two).Integer()).pow(two)
 			} else {
-				return x.Pow(y.Sub(NewUint64(1))).mul(x)
+				return x.pow(y.Sub(NewUint64(1))).mul(x)
 			}
 		}
 	}
@@ -107,40 +147,103 @@ func (x *Real) pow(y *Real) *Real {
 	return z
 }
 
+// Return x^y by binary exponentiation, squaring x once per bit of y.
 func (x *Real) ipow(y int) *Real {
 	x.validate()
 	if y < 0 {
 		return x.ipow(y * -1).reciprocal()
 	}
 
-	if y == 0 {
-		z := initFrom(x)
-		z.SetUint64(1)
-		return z
-	} else if y == 1 {
-		return x.Copy()
-	} else if y == 2 {
-		return x.mul(x)
+	z := initFrom(x)
+	z.SetUint64(1)
+	b := x
+	for ; y > 0; y >>= 1 {
+		if y&1 == 1 {
+			z = z.mul(b)
+		}
+		if y > 1 {
+			b = b.mul(b)
+		}
 	}
+	return z
+}
 
-	if y%2 == 0 {
-		return x.ipow(y / 2).ipow(2)
-	} else {
-		return x.ipow(y - 1).mul(x)
+// Return y as an int if it is an integer small enough for ipow.
+func (y *Real) smallInteger() (int, bool) {
+	if y.form != FormReal || !y.IsInteger() || y.exponent > 17 {
+		return 0, false
 	}
+	n := 0
+	for i := 0; i <= y.exponent; i++ {
+		n *= 10
+		n += int(y.digit(i))
+	}
+	if y.negative {
+		n = -n
+	}
+	return n, true
 }
 
-// Return the square root of x.
-func (x *Real) Sqrt() *Real {
-	x.validate()
+// Returns the non-integer y as the fraction num/den in lowest terms, if den is
+// odd. Since y = m/10^k, the denominator is odd only when 2^k divides m, which
+// leaves a power of five. den is 0 if it is too large for an int, and ok is
+// false if the denominator is even.
+func (y *Real) fraction() (num *Real, den int, ok bool) {
+	k := y.ndigits() - 1 - y.exponent
+	m := y.significandDigits()
 
-	x2 := x.Copy()
-	x2.pip(x.precision)
+	// divide m by 2^k, then cancel common factors of five
+	for i := 0; i < k; i++ {
+		if m[len(m)-1]%2 != 0 {
+			return nil, 0, false
+		}
+		m = divDigits(m, 2)
+	}
+	j := k
+	for j > 0 && m[len(m)-1]%5 == 0 {
+		m = divDigits(m, 5)
+		j--
+	}
 
-	half := initFrom(x2)
-	half.SetUint64(5)
-	half.exponent = -1
-	z := x2.pow(half)
-	z.SetPrecision(x.precision)
-	return z
+	num = initFrom(y)
+	num.exponent = len(m) - 1
+	num.setSignificandDigits(m)
+	num.negative = y.negative
+	num.precision = umax(y.precision, uint(len(m)))
+
+	if j < 28 {
+		den = 1
+		for ; j > 0; j-- {
+			den *= 5
+		}
+	}
+	return num, den, true
+}
+
+// Return the integer significand m divided by the digit d, which must divide
+// it exactly.
+func divDigits(m []byte, d byte) []byte {
+	q := make([]byte, 0, len(m))
+	var r byte
+	for _, v := range m {
+		r = r*10 + v
+		if len(q) > 0 || r >= d {
+			q = append(q, r/d)
+		}
+		r %= d
+	}
+	return q
+}
+
+// Return x^y for positive x, where y is the fraction num/den. Small fractions
+// are computed as the den-th root raised to num, which is exact when the
+// root is; others use the general power.
+func (x *Real) powFraction(y, num *Real, den int) *Real {
+	if n, ok := num.smallInteger(); ok && den != 0 && den <= maxRootDenominator {
+		r, exact := x.root(den)
+		if exact {
+			return r.ipow(n)
+		}
+	}
+	return x.pow(y)
 }
@@ -25,12 +25,22 @@ func TestIpow2(t *testing.T) {
 	}
 }
 
+// 5.1^-2 == 3.844675124951941560938100730488273740869e-2. ipow takes the
+// reciprocal by Newton's method at the working precision, which is within a
+// few units in the last place but not correctly rounded, while Pow is.
 func TestIpow3(t *testing.T) {
 	x := NewInt64(51)
 	x.exponent = 0
 	z := x.ipow(-2)
 
-	if z.String() != "3.844675124951941560938100730488276e-2" {
+	want, _ := ParseReal("3.844675124951941560938100730488273740869e-2", 50)
+	ulp, _ := ParseReal("1e-35", 50)
+	if d := want.Sub(z).Abs(); d.Compare(ulp.MulInt64(3)) > 0 {
+		t.Fatal("invalid power", z)
+	}
+
+	y := NewInt64(-2)
+	if z := x.Pow(y); z.String() != "3.844675124951941560938100730488274e-2" {
 		t.Fatal("invalid power", z)
 	}
 }
@@ -261,3 +271,69 @@ func TestSqrtNaN(t *testing.T) {
 		t.Fatal("invalid sqrt", z)
 	}
 }
+
+func TestPowNegativeBase(t *testing.T) {
+	tests := []struct {
+		x, y, want string
+	}{
+		{"-32", "0.2", "-2e0"},
+		{"-32", "0.4", "4e0"},
+		{"-32", "-0.2", "-5e-1"},
+		{"-27", "0.6", "-7.224674055842076138856525842573463e0"},
+		{"-2", "1.2", "2.297396709994070013597253893555855e0"},
+		{"-32", "0.5", "NaN"},
+		{"-8", "0.3333333333", "NaN"},
+	}
+
+	for _, v := range tests {
+		x, err := ParseReal(v.x, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		y, err := ParseReal(v.y, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := x.Pow(y); z.String() != v.want {
+			t.Fatal("invalid power", v.x, v.y, z)
+		}
+	}
+}
+
+func TestPowInteger(t *testing.T) {
+	tests := []struct {
+		x, y, want string
+	}{
+		{"3", "40", "1.2157665459056928801e19"},
+		{"7", "50", "1.798465042647412146620280340569649e42"},
+		{"2", "1000000", "9.900656229295898250697923616301903e301029"},
+		{"1.0000001", "1000000", "1.105170912549793416638382709346716e0"},
+		{"-2", "-3", "-1.25e-1"},
+	}
+
+	for _, v := range tests {
+		x, err := ParseReal(v.x, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		y, err := ParseReal(v.y, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := x.Pow(y); z.String() != v.want {
+			t.Fatal("invalid power", v.x, v.y, z)
+		}
+	}
+}
+
+func TestPowIntegerExactRounding(t *testing.T) {
+	// 3^40 == 12157665459056928801 is rounded once from the exact value.
+	x := NewInt64(3)
+	x.SetPrecision(10)
+	x.SetMode(ModeZero)
+	z := x.Pow(NewInt64(40))
+
+	if z.String() != "1.215766545e19" {
+		t.Fatal("invalid power", z)
+	}
+}
@@ -12,12 +12,12 @@ import (
 
 // A real number. Internally stored as a real number in decimal scientific notation.
 type Real struct {
-	significand []byte // decimal significand -- only valid values are 0-9
-	negative    bool   // true if the number is negative
-	exponent    int    // exponent
-	precision   uint   // maximum allowed precision of the significand in decimal digits
-	form        int    // other forms of an implementation of a real number -- infinity, NaN, etc.
-	mode        int    // rounding mode
+	significand limbs // decimal significand, as an integer without trailing zeros
+	negative    bool  // true if the number is negative
+	exponent    int   // exponent of the leading digit of the significand
+	precision   uint  // maximum allowed precision of the significand in decimal digits
+	form        int   // other forms of an implementation of a real number -- infinity, NaN, etc.
+	mode        int   // rounding mode
 }
 
 // Number forms
@@ -36,6 +36,10 @@ const (
 	float64MinimumDecimalPrecision = 15 // minimum number of correct decimal digits in a float64
 )
 
+// A bound on correct digits, for use with correctlyRounded, that marks a
+// result as exact.
+const exactDigits = math.MaxInt
+
 // Copy returns a deep copy of x.
 func (x *Real) Copy() *Real {
 	z := &Real{
@@ -52,8 +56,7 @@ func (x *Real) Copy() *Real {
 func (x *Real) CopyValue(y *Real) {
 	x.negative = y.negative
 	x.exponent = y.exponent
-	x.significand = make([]byte, len(y.significand))
-	copy(x.significand, y.significand)
+	x.significand = append(limbs(nil), y.significand...)
 	x.form = y.form
 	x.round()
 }
@@ -63,18 +66,15 @@ func (x *Real) CopyValue(y *Real) {
 // making new values based on operands.
 func initFrom(x *Real) *Real {
 	return &Real{
-		significand: []byte{},
-		precision:   x.precision,
-		mode:        x.mode,
+		precision: x.precision,
+		mode:      x.mode,
 	}
 }
 
 // Same as initFrom(), but takes the maximum precision of x,y. Mode and form
 // always copy from x.
 func initFrom2(x, y *Real) *Real {
-	r := &Real{
-		significand: []byte{},
-	}
+	r := &Real{}
 	if x.precision > y.precision {
 		r.precision = x.precision
 		r.mode = x.mode
@@ -136,24 +136,17 @@ func (x *Real) SetInt64(y int64) {
 // are left unchanged. If precision is lower than the given value, rounding
 // occurs.
 func (x *Real) SetUint64(y uint64) {
-	x.significand = []byte{}
+	var buf [3]uint32
+	x.significand, x.exponent = uint64Significand(y, &buf)
+	x.significand = append(limbs(nil), x.significand...)
 	x.negative = false
-	x.exponent = 0
-	if y == 0 {
-		return
-	}
-	for y != 0 {
-		x.significand = append([]byte{byte(y % 10)}, x.significand...)
-		y /= 10
-	}
-	x.exponent = len(x.significand) - 1
 	x.round()
 }
 
 // Set a real number to the given float64. Rounding mode and precision are left
 // unchanged. If precision is lower than the given value, rounding occurs.
 func (x *Real) SetFloat64(y float64) {
-	x.significand = []byte{}
+	x.significand = nil
 	x.negative = false
 
 	if y == 0 {
@@ -175,13 +168,36 @@ func (x *Real) SetFloat64(y float64) {
 	// an efficient binary to decimal algorithm is still a fantasy. Any
 	// approach here would be no better than just doing dtoa() and parsing
 	// the string, so we do exactly that...
-	s := fmt.Sprintf("%.17e", y)
+	x.setScientific(fmt.Sprintf("%.17e", y))
+	x.round()
+}
+
+// Set x to the exact binary value of the finite float64 y. Every float64 has
+// a terminating decimal expansion of at most 767 significant digits, so
+// formatting with that many digits yields the exact value. The value is not
+// rounded.
+func (x *Real) setFloat64Exact(y float64) {
+	x.significand = nil
+	x.negative = false
+	x.exponent = 0
+	if y == 0 {
+		return
+	}
+	x.setScientific(strconv.FormatFloat(y, 'e', 767, 64))
+	x.trim()
+}
+
+// Set the value of x from a string in the scientific notation produced by the
+// fmt and strconv packages (e.g. "-1.2345e+06"). The string must be well
+// formed.
+func (x *Real) setScientific(s string) {
 	if s[0] == '-' {
 		x.negative = true
 		s = s[1:]
 	}
 
 	// significand
+	var d []byte
 	for i, v := range s {
 		if v == 'e' {
 			s = s[i+1:]
@@ -190,7 +206,7 @@ func (x *Real) SetFloat64(y float64) {
 		if v == '.' {
 			continue
 		}
-		x.significand = append(x.significand, byte(v)-0x30)
+		d = append(d, byte(v)-0x30)
 	}
 
 	// exponent
@@ -199,24 +215,48 @@ func (x *Real) SetFloat64(y float64) {
 		panic(fmt.Sprintf("could not parse exponent %v", s))
 	}
 	x.exponent = exp
-	x.round()
+	x.setSignificandDigits(d)
 }
 
-// Trim removes leading and trailing zeros from a normalized value.
-func (x *Real) trim() {
-	var i int
-	for i = 0; i < len(x.significand); i++ {
-		if x.significand[i] != 0 {
-			break
-		}
+// Write the limbs of y into buf, returning the normalized significand and
+// exponent. Used to operate on native integers without allocating a temporary
+// Real.
+func uint64Significand(y uint64, buf *[3]uint32) (limbs, int) {
+	if y == 0 {
+		return nil, 0
 	}
-	x.significand = x.significand[i:]
-	x.exponent -= i
-	for i := len(x.significand) - 1; i >= 0; i-- {
-		if x.significand[i] != 0 {
-			break
-		}
-		x.significand = x.significand[:i]
+	e := 0
+	for y%10 == 0 {
+		y /= 10
+		e++
+	}
+	s := uint64Limbs(y, buf)
+	return s, e + s.ndigits() - 1
+}
+
+// Return a Real holding y, using buf as storage, suitable for use as an
+// operand alongside x. The precision is large enough to hold any int64
+// exactly, so y is never rounded before the operation.
+func int64Operand(x *Real, y int64, buf *[3]uint32) Real {
+	r := Real{
+		precision: umax(x.precision, 20),
+		mode:      x.mode,
+	}
+	if y < 0 {
+		r.significand, r.exponent = uint64Significand(uint64((^y)+1), buf)
+		r.negative = true
+	} else {
+		r.significand, r.exponent = uint64Significand(uint64(y), buf)
+	}
+	return r
+}
+
+// Trim removes trailing zeros from the significand. They do not change the
+// value, since the exponent is that of the leading digit.
+func (x *Real) trim() {
+	x.significand = x.significand.norm()
+	if len(x.significand) != 0 && x.significand[0]%10 == 0 {
+		x.significand = x.significand.shr(x.significand.trailingZeros())
 	}
 }
 
@@ -247,6 +287,13 @@ func abs(x int) int {
 	return x
 }
 
+// Negate x in place. Zero is never negative.
+func (x *Real) negate() {
+	if !x.IsZero() {
+		x.negative = !x.negative
+	}
+}
+
 // Return the absolute value of x.
 func (x *Real) Abs() *Real {
 	z := x.Copy()
@@ -274,7 +321,7 @@ func (x *Real) IsNaN() bool {
 
 // Returns true if x is an integer.
 func (x *Real) IsInteger() bool {
-	if x.exponent < len(x.significand)-1 {
+	if x.exponent < x.ndigits()-1 {
 		return false
 	}
 	return true
@@ -300,6 +347,70 @@ func (x *Real) Ceiling() *Real {
 	return x.Integer().Add(NewInt64(1))
 }
 
+// Return the correctly rounded result of f, which computes a value from x at
+// the given working precision w and returns it along with a bound on the
+// number of its leading digits that are correct. f is retried at increasing
+// working precision until the correct digits determine the rounding to the
+// precision of x, up to a limit of four times the initial working precision.
+// Past the limit, the most precise result is rounded without that guarantee.
+func (x *Real) correctlyRounded(f func(w uint) (*Real, int)) *Real {
+	w, maxw := x.workingPrecision()
+	for {
+		z, r := f(w)
+		if x.canRound(z, r) || w >= maxw {
+			return x.roundResult(z)
+		}
+		w += w / 2
+	}
+}
+
+// Returns the initial and maximum working precision used to correctly round
+// results to the precision of x. The working precision should grow by half
+// on each retry.
+func (x *Real) workingPrecision() (w, maxw uint) {
+	x.validate()
+	w = umax(x.precision, DefaultPrecision) + 2*internalPrecisionBuffer
+	return w, 4 * w
+}
+
+// Returns true if z, with r correct leading digits, can be correctly rounded
+// to the precision and rounding mode of x.
+func (x *Real) canRound(z *Real, r int) bool {
+	z.validate()
+	z.mode = x.mode
+	if z.form != FormReal || r == exactDigits {
+		return true
+	} else if r <= 0 {
+		return false
+	}
+
+	return z.roundable(x.precision, uint(r))
+}
+
+// Round z to the precision and rounding mode of x.
+func (x *Real) roundResult(z *Real) *Real {
+	z.mode = x.mode
+	z.SetPrecision(x.precision)
+	return z
+}
+
+// Return the number of decimal digits in the integer e, ignoring the sign.
+// Used to estimate the digits lost to an exponent when computing error bounds.
+func digits(e int) int {
+	n := 1
+	for e = abs(e); e >= 10; e /= 10 {
+		n++
+	}
+	return n
+}
+
+// Return a copy of x with the given working precision.
+func (x *Real) working(w uint) *Real {
+	z := x.Copy()
+	z.precision = w
+	return z
+}
+
 // Prepare internal precision -- used to set a sane internal precision before
 // performing an operation.
 func (x *Real) pip(p uint) {
@@ -12,7 +12,7 @@ import (
 func TestSetFloat64(t *testing.T) {
 	r := new(Real)
 	r.SetFloat64(1.23456789)
-	if bytes.Compare(r.significand, []byte{1, 2, 3, 4, 5, 6, 7, 8, 8, 9, 9, 9, 9, 9, 9, 9, 8, 9}) != 0 {
+	if bytes.Compare(r.significandDigits(), []byte{1, 2, 3, 4, 5, 6, 7, 8, 8, 9, 9, 9, 9, 9, 9, 9, 8, 9}) != 0 {
 		t.Fatal("SetFloat64 failed", r)
 	}
 	if r.negative {
@@ -26,7 +26,7 @@ func TestSetFloat64(t *testing.T) {
 func TestSetFloat642(t *testing.T) {
 	r := new(Real)
 	r.SetFloat64(.0000000000012414)
-	if bytes.Compare(r.significand, []byte{1, 2, 4, 1, 3, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 4}) != 0 {
+	if bytes.Compare(r.significandDigits(), []byte{1, 2, 4, 1, 3, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 4}) != 0 {
 		t.Fatal("SetFloat64 failed", r)
 	}
 	if r.negative {
@@ -40,7 +40,7 @@ func TestSetFloat642(t *testing.T) {
 func TestSetFloat643(t *testing.T) {
 	r := new(Real)
 	r.SetFloat64(12414223942231414151231231)
-	if bytes.Compare(r.significand, []byte{1, 2, 4, 1, 4, 2, 2, 3, 9, 4, 2, 2, 3, 1, 4, 1, 4, 1}) != 0 {
+	if bytes.Compare(r.significandDigits(), []byte{1, 2, 4, 1, 4, 2, 2, 3, 9, 4, 2, 2, 3, 1, 4, 1, 4, 1}) != 0 {
 		t.Fatal("SetFloat64 failed", r)
 	}
 	if r.negative {
@@ -55,8 +55,8 @@ func TestSetUint64(t *testing.T) {
 	r := new(Real)
 
 	r.SetUint64(1234567890)
-	if bytes.Compare(r.significand, []byte{1, 2, 3, 4, 5, 6, 7, 8, 9}) != 0 {
-		t.Fatal("SetUint64 failed", r.significand)
+	if bytes.Compare(r.significandDigits(), []byte{1, 2, 3, 4, 5, 6, 7, 8, 9}) != 0 {
+		t.Fatal("SetUint64 failed", r.significandDigits())
 	}
 	if r.negative {
 		t.Fatal("negative flag set")
@@ -70,7 +70,7 @@ func TestSetInt64(t *testing.T) {
 	r := new(Real)
 
 	r.SetInt64(9223372036854775807) // largest int64
-	if bytes.Compare(r.significand, []byte{9, 2, 2, 3, 3, 7, 2, 0, 3, 6, 8, 5, 4, 7, 7, 5, 8, 0, 7}) != 0 {
+	if bytes.Compare(r.significandDigits(), []byte{9, 2, 2, 3, 3, 7, 2, 0, 3, 6, 8, 5, 4, 7, 7, 5, 8, 0, 7}) != 0 {
 		t.Fatal("SetInt64 failed")
 	}
 	if r.negative {
@@ -81,8 +81,8 @@ func TestSetInt64(t *testing.T) {
 	}
 
 	r.SetInt64(-9223372036854775808) // smallest int64
-	if bytes.Compare(r.significand, []byte{9, 2, 2, 3, 3, 7, 2, 0, 3, 6, 8, 5, 4, 7, 7, 5, 8, 0, 8}) != 0 {
-		t.Fatal("SetInt64 failed", r.significand)
+	if bytes.Compare(r.significandDigits(), []byte{9, 2, 2, 3, 3, 7, 2, 0, 3, 6, 8, 5, 4, 7, 7, 5, 8, 0, 8}) != 0 {
+		t.Fatal("SetInt64 failed", r.significandDigits())
 	}
 	if !r.negative {
 		t.Fatal("negative flag not set")
@@ -92,8 +92,8 @@ func TestSetInt64(t *testing.T) {
 	}
 
 	r.SetInt64(0)
-	if bytes.Compare(r.significand, []byte{}) != 0 {
-		t.Fatal("SetInt64 failed", r.significand)
+	if bytes.Compare(r.significandDigits(), []byte{}) != 0 {
+		t.Fatal("SetInt64 failed", r.significandDigits())
 	}
 	if r.negative {
 		t.Fatal("negative flag set")
@@ -103,8 +103,8 @@ func TestSetInt64(t *testing.T) {
 	}
 
 	r.SetInt64(-1337)
-	if bytes.Compare(r.significand, []byte{1, 3, 3, 7}) != 0 {
-		t.Fatal("SetInt64 failed", r.significand)
+	if bytes.Compare(r.significandDigits(), []byte{1, 3, 3, 7}) != 0 {
+		t.Fatal("SetInt64 failed", r.significandDigits())
 	}
 	if !r.negative {
 		t.Fatal("negative flag not set")
@@ -129,72 +129,72 @@ func TestString(t *testing.T) {
 
 func TestTrim(t *testing.T) {
 	r := new(Real)
-	r.significand = []byte{0, 0, 0, 0, 0, 0, 0, 1, 2, 3, 4, 0, 0, 0, 0, 0}
+	r.setSignificandDigits([]byte{0, 0, 0, 0, 0, 0, 0, 1, 2, 3, 4, 0, 0, 0, 0, 0})
 
 	r.trim()
-	if bytes.Compare(r.significand, []byte{1, 2, 3, 4}) != 0 {
-		t.Fatal("invalid trim", r.significand)
+	if bytes.Compare(r.significandDigits(), []byte{1, 2, 3, 4}) != 0 {
+		t.Fatal("invalid trim", r.significandDigits())
 	}
 }
 
 func TestTrim2(t *testing.T) {
 	r := new(Real)
-	r.significand = []byte{1, 2, 3, 4, 0, 0, 0, 0, 0}
+	r.setSignificandDigits([]byte{1, 2, 3, 4, 0, 0, 0, 0, 0})
 
 	r.trim()
-	if bytes.Compare(r.significand, []byte{1, 2, 3, 4}) != 0 {
-		t.Fatal("invalid trim", r.significand)
+	if bytes.Compare(r.significandDigits(), []byte{1, 2, 3, 4}) != 0 {
+		t.Fatal("invalid trim", r.significandDigits())
 	}
 }
 
 func TestTrim3(t *testing.T) {
 	r := new(Real)
-	r.significand = []byte{1, 2, 3, 4}
+	r.setSignificandDigits([]byte{1, 2, 3, 4})
 
 	r.trim()
-	if bytes.Compare(r.significand, []byte{1, 2, 3, 4}) != 0 {
-		t.Fatal("invalid trim", r.significand)
+	if bytes.Compare(r.significandDigits(), []byte{1, 2, 3, 4}) != 0 {
+		t.Fatal("invalid trim", r.significandDigits())
 	}
 }
 
 func TestTrim4(t *testing.T) {
 	r := new(Real)
-	r.significand = []byte{1}
+	r.setSignificandDigits([]byte{1})
 
 	r.trim()
-	if bytes.Compare(r.significand, []byte{1}) != 0 {
-		t.Fatal("invalid trim", r.significand)
+	if bytes.Compare(r.significandDigits(), []byte{1}) != 0 {
+		t.Fatal("invalid trim", r.significandDigits())
 	}
 }
 
 func TestTrim5(t *testing.T) {
 	r := new(Real)
-	r.significand = []byte{0, 1, 0}
+	r.setSignificandDigits([]byte{0, 1, 0})
 
 	r.trim()
-	if bytes.Compare(r.significand, []byte{1}) != 0 {
-		t.Fatal("invalid trim", r.significand)
+	if bytes.Compare(r.significandDigits(), []byte{1}) != 0 {
+		t.Fatal("invalid trim", r.significandDigits())
 	}
 }
 
 func TestTrim6(t *testing.T) {
 	r := new(Real)
-	r.significand = []byte{}
+	r.setSignificandDigits([]byte{})
 
 	r.trim()
-	if bytes.Compare(r.significand, []byte{}) != 0 {
-		t.Fatal("invalid trim", r.significand)
+	if bytes.Compare(r.significandDigits(), []byte{}) != 0 {
+		t.Fatal("invalid trim", r.significandDigits())
 	}
 }
 
 func TestTrim7(t *testing.T) {
 	r := new(Real)
-	r.significand = []byte{0, 0, 0, 0, 0, 0, 0, 1, 2, 3, 4, 0, 0, 0, 0, 0}
 	r.exponent = 7
+	r.setSignificandDigits([]byte{0, 0, 0, 0, 0, 0, 0, 1, 2, 3, 4, 0, 0, 0, 0, 0})
 
 	r.trim()
-	if bytes.Compare(r.significand, []byte{1, 2, 3, 4}) != 0 {
-		t.Fatal("invalid trim", r.significand)
+	if bytes.Compare(r.significandDigits(), []byte{1, 2, 3, 4}) != 0 {
+		t.Fatal("invalid trim", r.significandDigits())
 	}
 	if r.exponent != 0 {
 		t.Fatal("invalid exponent", r.exponent)
@@ -272,32 +272,32 @@ func TestRound7(t *testing.T) {
 func TestInteger1(t *testing.T) {
 	x := NewFloat64(1.234)
 	z := x.Integer()
-	if bytes.Compare(z.significand, []byte{1}) != 0 {
-		t.Fatal("invalid significand", z.significand)
+	if bytes.Compare(z.significandDigits(), []byte{1}) != 0 {
+		t.Fatal("invalid significand", z.significandDigits())
 	}
 }
 
 func TestInteger2(t *testing.T) {
 	x := NewFloat64(12.34)
 	z := x.Integer()
-	if bytes.Compare(z.significand, []byte{1, 2}) != 0 {
-		t.Fatal("invalid significand", z.significand)
+	if bytes.Compare(z.significandDigits(), []byte{1, 2}) != 0 {
+		t.Fatal("invalid significand", z.significandDigits())
 	}
 }
 
 func TestInteger3(t *testing.T) {
 	x := NewFloat64(123.4)
 	z := x.Integer()
-	if bytes.Compare(z.significand, []byte{1, 2, 3}) != 0 {
-		t.Fatal("invalid significand", z.significand)
+	if bytes.Compare(z.significandDigits(), []byte{1, 2, 3}) != 0 {
+		t.Fatal("invalid significand", z.significandDigits())
 	}
 }
 
 func TestInteger4(t *testing.T) {
 	x := NewFloat64(1234.0)
 	z := x.Integer()
-	if bytes.Compare(z.significand, []byte{1, 2, 3, 4}) != 0 {
-		t.Fatal("invalid significand", z.significand)
+	if bytes.Compare(z.significandDigits(), []byte{1, 2, 3, 4}) != 0 {
+		t.Fatal("invalid significand", z.significandDigits())
 	}
 }
 
@@ -0,0 +1,191 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import (
+	"math"
+	"strconv"
+)
+
+// Return the square root of x. The result is correctly rounded, and exact for
+// perfect squares.
+func (x *Real) Sqrt() *Real {
+	return x.NthRoot(2)
+}
+
+func (x *Real) sqrt() *Real {
+	z, _ := x.root(2)
+	return z
+}
+
+// Return the cube root of x. The result is correctly rounded, and exact for
+// perfect cubes.
+func (x *Real) Cbrt() *Real {
+	return x.NthRoot(3)
+}
+
+// Return the nth root of x. The result is correctly rounded, and exact for
+// perfect powers. Negative values of x have a real root only when n is odd;
+// otherwise, and for n < 1, the result is NaN.
+func (x *Real) NthRoot(n int) *Real {
+	if n < 1 {
+		z := initFrom(x)
+		z.form = FormNaN
+		return z
+	}
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		z, exact := x.working(w).root(n)
+		if exact {
+			return z, exactDigits
+		}
+		return z, int(w) - internalPrecisionBuffer
+	})
+}
+
+// Return the nth root of x, and whether it is exact, using Newton's method,
+// z = ((n-1)z + x/z^(n-1)) / n.
+func (x *Real) root(n int) (*Real, bool) {
+	z := initFrom(x)
+	if x.IsNaN() || (x.negative && n%2 == 0) {
+		z.form = FormNaN
+		return z, true
+	} else if x.IsInf() {
+		z.form = FormInf
+		z.negative = x.negative
+		return z, true
+	} else if x.IsZero() || n == 1 {
+		z.CopyValue(x)
+		return z, true
+	}
+
+	a := x.Copy()
+	a.negative = false
+
+	// Seed with a float64 estimate. The exponent is split into a multiple
+	// of n, which divides exactly, and a remainder in [0, n) that is folded
+	// into the logarithm of the significand so large n cannot overflow.
+	k := a.exponent / n
+	if a.exponent%n < 0 {
+		k--
+	}
+	m := a.Copy()
+	m.exponent = 0
+	f, err := strconv.ParseFloat(m.String(), 64)
+	if err != nil {
+		panic("could not parse float")
+	}
+	f = math.Pow(10, (math.Log10(f)+float64(a.exponent-k*n))/float64(n))
+	z.SetFloat64(f)
+	z.exponent += k
+
+	nr := initFrom(x)
+	nr.SetInt64(int64(n))
+	n1 := initFrom(x)
+	n1.SetInt64(int64(n - 1))
+
+	// Each iteration doubles the number of correct digits, so it only needs
+	// twice the precision of the one before. The last iteration is repeated
+	// at full precision to absorb rounding error.
+	steps := []uint{x.precision, x.precision}
+	for p := (x.precision + 1) / 2; p > float64MinimumDecimalPrecision; p = (p + 1) / 2 {
+		steps = append(steps, p)
+	}
+	for i := len(steps) - 1; i >= 0; i-- {
+		p := steps[i]
+		z.precision = p
+		nr.precision = p
+		n1.precision = p
+		z = n1.mul(z).Add(a.working(p).div(z.ipow(n - 1))).div(nr)
+	}
+
+	exact := z.exactRoot(a, n)
+	if exact != nil {
+		z = exact
+	}
+	if x.negative {
+		z.negate()
+	}
+	return z, exact != nil
+}
+
+// Return the exact nth root of a if z approximates it to within the last few
+// digits, or nil if the root of a is not exact. The approximation is rounded
+// to drop the unreliable digits, and accepted if its nth power is a.
+func (z *Real) exactRoot(a *Real, n int) *Real {
+	r := z.Copy()
+	r.mode = ModeNearest
+	r.SetPrecision(z.precision - internalPrecisionBuffer)
+
+	// The significand of r^n ends in a nonzero digit, so its length is
+	// bounded by the length of r. Skip the exact power when it could not
+	// possibly match.
+	l := r.ndigits()
+	if a.ndigits() < n*(l-1)+1 || a.ndigits() > n*l {
+		return nil
+	}
+
+	r.precision = uint(n*l) + 1
+	if r.ipow(n).Compare(a) != 0 {
+		return nil
+	}
+	r.mode = z.mode
+	r.precision = z.precision
+	return r
+}
+
+// Return the square root of x²+y², without undue overflow or underflow. The
+// result is correctly rounded, and has the precision and rounding mode of x.
+func (x *Real) Hypot(y *Real) *Real {
+	y.validate()
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		z, exact := x.working(w).hypot(y)
+		if exact {
+			return z, exactDigits
+		}
+		return z, int(w) - internalPrecisionBuffer - 1
+	})
+}
+
+func (x *Real) hypot(y *Real) (*Real, bool) {
+	z := initFrom(x)
+	if x.IsInf() || y.IsInf() {
+		z.form = FormInf
+		return z, true
+	} else if x.IsNaN() || y.IsNaN() {
+		z.form = FormNaN
+		return z, true
+	}
+
+	a := x.Abs()
+	b := y.Abs()
+	if a.Compare(b) == -1 {
+		a, b = b, a
+	}
+	if b.IsZero() {
+		z.CopyValue(a)
+		return z, true
+	} else if b.exponent < a.exponent-int(x.precision) {
+		// b² is far below the precision of a², so the result is a
+		// plus a tiny amount that cannot affect its rounding.
+		z.CopyValue(a)
+		return z, false
+	}
+
+	// Scale both values by the same power of ten so the squares cannot
+	// overflow, and sum the squares exactly.
+	e := a.exponent
+	a.exponent = 0
+	b.exponent -= e
+	var s Accumulator
+	s.AddProduct(a, a)
+	s.AddProduct(b, b)
+
+	sum := s.sum
+	sum.precision = x.precision
+	sum.mode = x.mode
+	z, exact := sum.root(2)
+	z.exponent += e
+	return z, exact
+}
@@ -0,0 +1,128 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import "testing"
+
+func TestSqrtExact(t *testing.T) {
+	tests := []struct {
+		x, want string
+	}{
+		{"4", "2e0"},
+		{"0.0625", "2.5e-1"},
+		{"1e-40", "1e-20"},
+		{"1.21e100", "1.1e50"},
+		{"15241578750190521", "1.23456789e8"},
+	}
+
+	for _, v := range tests {
+		x, err := ParseReal(v.x, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := x.Sqrt(); z.String() != v.want {
+			t.Fatal("invalid sqrt", v.x, z)
+		}
+	}
+}
+
+func TestSqrtModeZero(t *testing.T) {
+	// An exact root must not be truncated to 0.99...9.
+	x := NewInt64(9)
+	x.SetMode(ModeZero)
+	z := x.Sqrt()
+
+	if z.String() != "3e0" {
+		t.Fatal("invalid sqrt", z)
+	}
+}
+
+func TestCbrt(t *testing.T) {
+	tests := []struct {
+		x, want string
+	}{
+		{"-8", "-2e0"},
+		{"2", "1.259921049894873164767210607278228e0"},
+		{"1e-40", "4.641588833612778892410076350919447e-14"},
+		{"15241578750190521", "2.479381281559047969967792266030838e5"},
+	}
+
+	for _, v := range tests {
+		x, err := ParseReal(v.x, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := x.Cbrt(); z.String() != v.want {
+			t.Fatal("invalid cbrt", v.x, z)
+		}
+	}
+}
+
+func TestNthRoot(t *testing.T) {
+	tests := []struct {
+		x    string
+		n    int
+		want string
+	}{
+		{"1e-40", 5, "1e-8"},
+		{"-8", 5, "-1.515716566510398082347259801306445e0"},
+		{"-8", 4, "NaN"},
+		{"2", 1000, "1.000693387462580632537568639303859e0"},
+		{"1.21e100", 1000, "1.259165411482570615235674949091694e0"},
+		{"2", 0, "NaN"},
+		{"2", 1, "2e0"},
+	}
+
+	for _, v := range tests {
+		x, err := ParseReal(v.x, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := x.NthRoot(v.n); z.String() != v.want {
+			t.Fatal("invalid root", v.x, v.n, z)
+		}
+	}
+}
+
+func TestHypot(t *testing.T) {
+	tests := []struct {
+		x, y, want string
+	}{
+		{"3", "4", "5e0"},
+		{"5", "-12", "1.3e1"},
+		{"1e300", "1e300", "1.414213562373095048801688724209698e300"},
+		{"-3e-500", "4e-500", "5e-500"},
+		{"1", "1e-60", "1e0"},
+		{"0", "-7", "7e0"},
+	}
+
+	for _, v := range tests {
+		x, err := ParseReal(v.x, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		y, err := ParseReal(v.y, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := x.Hypot(y); z.String() != v.want {
+			t.Fatal("invalid hypot", v.x, v.y, z)
+		}
+	}
+
+	x := NewInt64(5)
+	x.SetMode(ModeZero)
+	if z := x.Hypot(NewInt64(12)); z.String() != "1.3e1" {
+		t.Fatal("invalid hypot", z)
+	}
+
+	x = new(Real)
+	x.form = FormNaN
+	y := new(Real)
+	y.form = FormInf
+	if z := x.Hypot(y); !z.IsInf() {
+		t.Fatal("invalid hypot", z)
+	}
+}
@@ -50,113 +50,36 @@ func (x *Real) round() {
 func (x *Real) roundTo(p uint) {
 	defer x.trim()
 
-	if uint(len(x.significand)) <= p {
+	n := x.ndigits()
+	if uint(n) <= p {
 		// number is exact, no rounding needed.
 		return
 	}
 
+	// The digits below position cut are dropped, and d is the first of
+	// them.
+	cut := n - int(p)
+	d := x.significand.digit(cut - 1)
+
+	var up bool
 	switch x.mode {
 	case ModeNearestEven:
-		x.roundToNearestEven(p)
+		// round up if any of remaining digits are non-zero, and
+		// otherwise to nearest even
+		up = d > 5 || (d == 5 && (x.significand.nonzeroBelow(cut-1) || x.significand.digit(cut)%2 != 0))
 	case ModeNearest:
-		x.roundToNearest(p)
+		up = d >= 5
 	case ModeZero:
 		// just truncate
 	}
 
-	x.significand = x.significand[:p]
-}
-
-func (x *Real) roundToNearestEven(p uint) {
-	d := x.significand[p]
-
-	switch {
-	case d < 5:
-		// round down
-	case d > 5:
-		// round up
-		if p == 0 {
-			x.significand[0] = 1
-			x.exponent++
-			return
-		} else {
-			x.significand[p-1]++
-		}
-	case d == 5:
-		// round up if any of remaining digits are non-zero
-		var nonzero bool
-		for i := int(p) + 1; i < len(x.significand); i++ {
-			if x.significand[i] != 0 {
-				nonzero = true
-				break
-			}
-		}
-		if nonzero {
-			if p == 0 {
-				x.significand[0] = 1
-				x.exponent++
-				return
-			} else {
-				x.significand[p-1]++
-			}
-		} else {
-			// round to nearest even!
-			if p != 0 {
-				if x.significand[p-1]%2 != 0 {
-					x.significand[p-1]++
-				}
-			}
-		}
-	}
-
-	// now unwind to the left to make sure we don't have any lingering carry
-	for i := int(p) - 1; i >= 0; i-- {
-		if x.significand[i] < 10 {
-			break
-		}
-		x.significand[i] -= 10
-
-		if i == 0 {
-			// pad
-			x.significand = append([]byte{1}, x.significand...)
-			x.exponent++
-			break
-		}
-		x.significand[i-1]++
-	}
-}
-
-func (x *Real) roundToNearest(p uint) {
-	d := x.significand[p]
-
-	switch {
-	case d < 5:
-		// round down
-	case d >= 5:
-		// round up
-		if p == 0 {
-			x.significand[0] = 1
-			x.exponent++
-			return
-		} else {
-			x.significand[p-1]++
-		}
-	}
-
-	// now unwind to the left to make sure we don't have any lingering carry
-	for i := int(p) - 1; i >= 0; i-- {
-		if x.significand[i] < 10 {
-			break
-		}
-		x.significand[i] -= 10
-
-		if i == 0 {
-			// pad
-			x.significand = append([]byte{1}, x.significand...)
+	x.significand = x.significand.shr(cut)
+	if up {
+		x.significand = x.significand.inc()
+		if uint(x.ndigits()) > p {
+			// carried into a new leading digit
 			x.exponent++
-			break
 		}
-		x.significand[i-1]++
 	}
 }
 
@@ -171,7 +94,7 @@ func (x *Real) RoundedInteger() *Real {
 	if z.exponent < 0 {
 		z.roundTo(1)
 		if z.exponent == -1 {
-			d := z.significand[0]
+			d := z.digit(0)
 			switch {
 			case d < 5:
 				z.SetInt64(0)
@@ -198,3 +121,35 @@ func (x *Real) RoundedInteger() *Real {
 	}
 	return z
 }
+
+// Returns true if x, an approximation whose first r significant digits are
+// correct to within one unit in the last place, rounds to p digits the same
+// way the exact value would. Rounding is ambiguous when the correct digits
+// beyond p sit on a rounding boundary -- "50...0" or "49...9" when rounding
+// to nearest, and "00...0" or "99...9" when truncating.
+func (x *Real) roundable(p, r uint) bool {
+	if r <= p {
+		return false
+	}
+
+	digit := func(i uint) byte {
+		return x.digit(int(i))
+	}
+
+	allDigits := func(d byte) bool {
+		for i := p + 1; i < r; i++ {
+			if digit(i) != d {
+				return false
+			}
+		}
+		return true
+	}
+
+	first := digit(p)
+	switch x.mode {
+	case ModeZero:
+		return !(first == 0 && allDigits(0)) && !(first == 9 && allDigits(9))
+	default:
+		return !(first == 5 && allDigits(0)) && !(first == 4 && allDigits(9))
+	}
+}
@@ -19,3 +19,40 @@ func TestRoundHalfEven1(t *testing.T) {
 		t.Fatal("invalid round", z)
 	}
 }
+
+func TestRoundable(t *testing.T) {
+	x, _ := ParseReal("1.2345000001", 20)
+	if x.roundable(4, 10) {
+		t.Fatal("ambiguous value reported roundable")
+	}
+	if !x.roundable(4, 11) {
+		t.Fatal("unambiguous value reported not roundable")
+	}
+
+	x, _ = ParseReal("1.2344999999", 20)
+	if x.roundable(4, 10) {
+		t.Fatal("ambiguous value reported roundable")
+	}
+
+	x.SetMode(ModeZero)
+	if !x.roundable(4, 10) {
+		t.Fatal("unambiguous value reported not roundable")
+	}
+	if x.roundable(5, 10) {
+		t.Fatal("ambiguous value reported roundable")
+	}
+}
+
+// A short result is not known to be exact unless it is marked exact, since
+// the digits past it may be just above or below zero.
+func TestCanRoundShort(t *testing.T) {
+	x, _ := ParseReal("4e-151", 3)
+	x.SetMode(ModeZero)
+	z, _ := ParseReal("4e-151", 20)
+	if x.canRound(z, 20) {
+		t.Fatal("ambiguous short value reported roundable")
+	}
+	if !x.canRound(z, exactDigits) {
+		t.Fatal("exact value reported not roundable")
+	}
+}
@@ -11,14 +11,13 @@ import "fmt"
 // function will panic.
 const MaxTrigIterations = 1000
 
-// Return the sine of x, where x is in radians.
+// Return the sine of x, where x is in radians. The result is correctly
+// rounded.
 func (x *Real) Sin() *Real {
-	x.validate()
-	x2 := x.Copy()
-	x2.pip(x.precision)
-	z := x2.sin()
-	z.SetPrecision(x.precision)
-	return z
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		z := x.working(w).sin()
+		return z, x.trigCorrectDigits(w, z)
+	})
 }
 
 func (x *Real) sin() *Real {
@@ -31,181 +30,287 @@ func (x *Real) sin() *Real {
 		return z
 	}
 
-	z := initFrom(x)
-
-	n1 := initFrom(x)
-	n1.SetInt64(-1)
-	two := initFrom(x)
-	two.SetInt64(2)
-	one := initFrom(x)
-	one.SetInt64(1)
-
-	twoPi := initFrom(x)
-	twoPi.significand = make([]byte, len(π))
-	copy(twoPi.significand, π)
-	twoPi.round()
-	twoPi = twoPi.mul(two)
-
-	xx := x
-	if xx.Compare(twoPi) == 1 {
-		xx = xx.Remainder(twoPi)
+	// sin(kπ/2 + r) cycles through sin(r), cos(r), -sin(r), -cos(r).
+	q, r, neg := x.reduce()
+	var z *Real
+	if q%2 == 0 {
+		z = r.trigSeries(true)
+		if neg {
+			z.negate()
+		}
+	} else {
+		z = r.trigSeries(false)
 	}
+	if q >= 2 {
+		z.negate()
+	}
+	return z
+}
 
-	var converged bool
-	for i := 0; i < MaxTrigIterations; i++ {
-		twoNone := initFrom(x)
-		twoNone.SetUint64(uint64(i))
-		twoNone = twoNone.mul(two).Add(one)
+// Return the cosine of x, where x is in radians. The result is correctly
+// rounded.
+func (x *Real) Cos() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		z := x.working(w).cos()
+		return z, x.trigCorrectDigits(w, z)
+	})
+}
 
-		n := n1.ipow(i)
-		d := twoNone.Factorial()
-		c := xx.pow(twoNone)
+func (x *Real) cos() *Real {
+	if x.IsInf() || x.IsNaN() {
+		z := initFrom(x)
+		z.form = FormNaN
+		return z
+	} else if x.IsZero() {
+		z := initFrom(x)
+		z.SetInt64(1)
+		return z
+	}
 
-		zn := z.Add(n.div(d).mul(c))
-		if z.Compare(zn) == 0 {
-			z = zn
-			converged = true
-			break
+	// cos(kπ/2 + r) cycles through cos(r), -sin(r), -cos(r), sin(r).
+	q, r, neg := x.reduce()
+	var z *Real
+	if q%2 == 0 {
+		z = r.trigSeries(false)
+	} else {
+		z = r.trigSeries(true)
+		if neg {
+			z.negate()
 		}
-		z = zn
 	}
-	if !converged {
-		panic(fmt.Sprintf("failed to converge sin(%v)", x))
+	if q == 1 || q == 2 {
+		z.negate()
 	}
-
 	return z
 }
 
-// Return the cosine of x, where x is in radians.
-func (x *Real) Cos() *Real {
-	x.validate()
-	x2 := x.Copy()
-	x2.pip(x.precision)
-	z := x2.cos()
-	z.SetPrecision(x.precision)
-	return z
+// Return the tangent of x, where x is in radians. The result is correctly
+// rounded.
+func (x *Real) Tan() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		// Both small and large results come from dividing by a small
+		// sine or cosine, so digits are lost either way.
+		z := x.working(w).tan()
+		return z, int(w) - internalPrecisionBuffer - abs(z.exponent)
+	})
 }
 
-func (x *Real) cos() *Real {
+func (x *Real) tan() *Real {
 	if x.IsInf() || x.IsNaN() {
 		z := initFrom(x)
 		z.form = FormNaN
 		return z
 	} else if x.IsZero() {
 		z := initFrom(x)
-		z.SetInt64(1)
 		return z
 	}
 
-	z := initFrom(x)
+	s, c := x.sincos()
+	return s.div(c)
+}
 
-	n1 := initFrom(x)
-	n1.SetInt64(-1)
-	two := initFrom(x)
-	two.SetInt64(2)
-	one := initFrom(x)
-	one.SetInt64(1)
+// Return the sine and cosine of x, where x is in radians. Both results are
+// correctly rounded, and share the argument reduction and series evaluation.
+func (x *Real) SinCos() (s, c *Real) {
+	w, maxw := x.workingPrecision()
+	for {
+		s, c = x.working(w).sincos()
+		if (x.canRound(s, x.trigCorrectDigits(w, s)) && x.canRound(c, x.trigCorrectDigits(w, c))) || w >= maxw {
+			return x.roundResult(s), x.roundResult(c)
+		}
+		w += w / 2
+	}
+}
 
-	twoPi := initFrom(x)
-	twoPi.significand = make([]byte, len(π))
-	copy(twoPi.significand, π)
-	twoPi.round()
-	twoPi = twoPi.mul(two)
+func (x *Real) sincos() (s, c *Real) {
+	s = initFrom(x)
+	c = initFrom(x)
+	if x.IsInf() || x.IsNaN() {
+		s.form = FormNaN
+		c.form = FormNaN
+		return s, c
+	} else if x.IsZero() {
+		c.SetInt64(1)
+		return s, c
+	}
+
+	q, r, neg := x.reduce()
+
+	// The terms r^n/n! of the exponential series alternate between the
+	// cosine (even n) and sine (odd n), with signs repeating every four
+	// terms.
+	t := initFrom(r)
+	t.SetInt64(1)
+	var unchanged int
+	for i := 0; i < 2*MaxTrigIterations; i++ {
+		var z *Real
+		switch i % 4 {
+		case 0:
+			z = c.Add(t)
+		case 1:
+			z = s.Add(t)
+		case 2:
+			z = c.Sub(t)
+		case 3:
+			z = s.Sub(t)
+		}
 
-	xx := x
-	if xx.Compare(twoPi) == 1 {
-		xx = xx.Remainder(twoPi)
+		if i%2 == 0 {
+			if c.Compare(z) == 0 {
+				unchanged++
+			} else {
+				unchanged = 0
+			}
+			c = z
+		} else {
+			if s.Compare(z) == 0 {
+				unchanged++
+			} else {
+				unchanged = 0
+			}
+			s = z
+		}
+		if unchanged == 2 {
+			if neg {
+				s.negate()
+			}
+			s, c = rotate(q, s, c)
+			return s, c
+		}
+
+		t = t.mul(r).divUint64(uint64(i + 1))
 	}
+	panic(fmt.Sprintf("failed to converge sincos(%v)", x))
+}
 
-	var converged bool
-	for i := 0; i < MaxTrigIterations; i++ {
-		twoN := initFrom(x)
-		twoN.SetUint64(uint64(i))
-		twoN = twoN.mul(two)
+// Return the Taylor series of the sine (odd) or cosine (even) of x. The
+// series converges quickly for x in [0, π/4].
+func (x *Real) trigSeries(odd bool) *Real {
+	t := initFrom(x)
+	t.SetInt64(1)
+	n := uint64(0)
+	if odd {
+		t = x.Copy()
+		n = 1
+	}
+	xx := x.mul(x)
 
-		n := n1.ipow(i)
-		d := twoN.Factorial()
-		c := xx.pow(twoN)
+	z := t
+	for i := 0; i < MaxTrigIterations; i++ {
+		t = t.mul(xx).divUint64((n + 1) * (n + 2))
+		t.negate()
+		n += 2
 
-		zn := z.Add(n.div(d).mul(c))
+		zn := z.Add(t)
 		if z.Compare(zn) == 0 {
-			z = zn
-			converged = true
-			break
+			return zn
 		}
 		z = zn
 	}
-	if !converged {
-		panic(fmt.Sprintf("failed to converge sin(%v)", x))
+	panic(fmt.Sprintf("failed to converge trigSeries(%v)", x))
+}
+
+// Reduce x modulo π/2, returning the quadrant q in [0, 4) and r in [0, π/4]
+// such that x = kπ/2 + r, or x = kπ/2 - r if neg is true, where k ≡ q
+// (mod 4). π is computed with enough digits that r has an absolute error
+// below the precision of x regardless of the magnitude of x.
+func (x *Real) reduce() (q int, r *Real, neg bool) {
+	if x.exponent < -1 || (x.exponent == -1 && x.digit(0) < 7) {
+		// |x| < 0.7 < π/4
+		r = x.Copy()
+		neg = r.negative
+		r.negative = false
+		return 0, r, neg
 	}
 
-	return z
+	xp := x.working(x.precision + uint(max(x.exponent+1, 0)) + internalPrecisionBuffer)
+	halfPi := pi(xp).divUint64(2)
+
+	// k is the nearest integer to x/(π/2)
+	half := initFrom(xp)
+	half.SetInt64(5)
+	half.exponent = -1
+	t := xp.div(halfPi)
+	half.negative = t.negative
+	k := t.Add(half).Integer()
+
+	r = xp.Sub(k.mul(halfPi))
+	r.precision = x.precision
+	r.round()
+	neg = r.negative
+	r.negative = false
+	return k.mod4(), r, neg
 }
 
-// Return the tangent of x, where x is in radians.
-func (x *Real) Tan() *Real {
-	x.validate()
-	x2 := x.Copy()
-	x2.pip(x.precision)
-	z := x2.tan()
-	z.SetPrecision(x.precision)
-	return z
+// Return the integer x modulo 4, in [0, 4). Only the last two digits of an
+// integer determine its residue.
+func (x *Real) mod4() int {
+	var v int
+	for i := max(x.exponent-1, 0); i <= x.exponent; i++ {
+		v *= 10
+		v += int(x.digit(i))
+	}
+	v %= 4
+	if x.negative {
+		v = (4 - v) % 4
+	}
+	return v
 }
 
-func (x *Real) tan() *Real {
-	if x.IsInf() || x.IsNaN() {
-		z := initFrom(x)
-		z.form = FormNaN
-		return z
-	} else if x.IsZero() {
-		z := initFrom(x)
-		return z
+// Return the sine and cosine of an angle rotated by q quarter turns, given
+// the sine s and cosine c of the unrotated angle.
+func rotate(q int, s, c *Real) (*Real, *Real) {
+	switch q {
+	case 1:
+		s, c = c, s
+		c.negate()
+	case 2:
+		s.negate()
+		c.negate()
+	case 3:
+		s, c = c, s
+		s.negate()
 	}
+	return s, c
+}
 
-	s := x.sin()
-	c := x.cos()
+// Return the secant of x, where x is in radians. The result is correctly
+// rounded.
+func (x *Real) Sec() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		xw := x.working(w)
+		one := initFrom(xw)
+		one.SetInt64(1)
+		c := xw.cos()
+		return one.div(c), x.trigCorrectDigits(w, c)
+	})
+}
 
-	z := s.div(c)
+// Return the cosecant of x, where x is in radians. The result is correctly
+// rounded.
+func (x *Real) Csc() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		xw := x.working(w)
+		one := initFrom(xw)
+		one.SetInt64(1)
+		s := xw.sin()
+		return one.div(s), x.trigCorrectDigits(w, s)
+	})
+}
 
-	return z
+// Return the cotangent of x, where x is in radians. The result is correctly
+// rounded.
+func (x *Real) Cot() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		s, c := x.working(w).sincos()
+		return c.div(s), min(x.trigCorrectDigits(w, s), x.trigCorrectDigits(w, c)) - 1
+	})
 }
 
-var π = []byte{3, 1, 4, 1, 5, 9, 2, 6, 5, 3, 5, 8, 9, 7, 9, 3, 2, 3, 8, 4, 6,
-	2, 6, 4, 3, 3, 8, 3, 2, 7, 9, 5, 0, 2, 8, 8, 4, 1, 9, 7, 1, 6, 9, 3, 9, 9, 3,
-	7, 5, 1, 0, 5, 8, 2, 0, 9, 7, 4, 9, 4, 4, 5, 9, 2, 3, 0, 7, 8, 1, 6, 4, 0, 6,
-	2, 8, 6, 2, 0, 8, 9, 9, 8, 6, 2, 8, 0, 3, 4, 8, 2, 5, 3, 4, 2, 1, 1, 7, 0, 6,
-	7, 9, 8, 2, 1, 4, 8, 0, 8, 6, 5, 1, 3, 2, 8, 2, 3, 0, 6, 6, 4, 7, 0, 9, 3, 8,
-	4, 4, 6, 0, 9, 5, 5, 0, 5, 8, 2, 2, 3, 1, 7, 2, 5, 3, 5, 9, 4, 0, 8, 1, 2, 8,
-	4, 8, 1, 1, 1, 7, 4, 5, 0, 2, 8, 4, 1, 0, 2, 7, 0, 1, 9, 3, 8, 5, 2, 1, 1, 0,
-	5, 5, 5, 9, 6, 4, 4, 6, 2, 2, 9, 4, 8, 9, 5, 4, 9, 3, 0, 3, 8, 1, 9, 6, 4, 4,
-	2, 8, 8, 1, 0, 9, 7, 5, 6, 6, 5, 9, 3, 3, 4, 4, 6, 1, 2, 8, 4, 7, 5, 6, 4, 8,
-	2, 3, 3, 7, 8, 6, 7, 8, 3, 1, 6, 5, 2, 7, 1, 2, 0, 1, 9, 0, 9, 1, 4, 5, 6, 4,
-	8, 5, 6, 6, 9, 2, 3, 4, 6, 0, 3, 4, 8, 6, 1, 0, 4, 5, 4, 3, 2, 6, 6, 4, 8, 2,
-	1, 3, 3, 9, 3, 6, 0, 7, 2, 6, 0, 2, 4, 9, 1, 4, 1, 2, 7, 3, 7, 2, 4, 5, 8, 7,
-	0, 0, 6, 6, 0, 6, 3, 1, 5, 5, 8, 8, 1, 7, 4, 8, 8, 1, 5, 2, 0, 9, 2, 0, 9, 6,
-	2, 8, 2, 9, 2, 5, 4, 0, 9, 1, 7, 1, 5, 3, 6, 4, 3, 6, 7, 8, 9, 2, 5, 9, 0, 3,
-	6, 0, 0, 1, 1, 3, 3, 0, 5, 3, 0, 5, 4, 8, 8, 2, 0, 4, 6, 6, 5, 2, 1, 3, 8, 4,
-	1, 4, 6, 9, 5, 1, 9, 4, 1, 5, 1, 1, 6, 0, 9, 4, 3, 3, 0, 5, 7, 2, 7, 0, 3, 6,
-	5, 7, 5, 9, 5, 9, 1, 9, 5, 3, 0, 9, 2, 1, 8, 6, 1, 1, 7, 3, 8, 1, 9, 3, 2, 6,
-	1, 1, 7, 9, 3, 1, 0, 5, 1, 1, 8, 5, 4, 8, 0, 7, 4, 4, 6, 2, 3, 7, 9, 9, 6, 2,
-	7, 4, 9, 5, 6, 7, 3, 5, 1, 8, 8, 5, 7, 5, 2, 7, 2, 4, 8, 9, 1, 2, 2, 7, 9, 3,
-	8, 1, 8, 3, 0, 1, 1, 9, 4, 9, 1, 2, 9, 8, 3, 3, 6, 7, 3, 3, 6, 2, 4, 4, 0, 6,
-	5, 6, 6, 4, 3, 0, 8, 6, 0, 2, 1, 3, 9, 4, 9, 4, 6, 3, 9, 5, 2, 2, 4, 7, 3, 7,
-	1, 9, 0, 7, 0, 2, 1, 7, 9, 8, 6, 0, 9, 4, 3, 7, 0, 2, 7, 7, 0, 5, 3, 9, 2, 1,
-	7, 1, 7, 6, 2, 9, 3, 1, 7, 6, 7, 5, 2, 3, 8, 4, 6, 7, 4, 8, 1, 8, 4, 6, 7, 6,
-	6, 9, 4, 0, 5, 1, 3, 2, 0, 0, 0, 5, 6, 8, 1, 2, 7, 1, 4, 5, 2, 6, 3, 5, 6, 0,
-	8, 2, 7, 7, 8, 5, 7, 7, 1, 3, 4, 2, 7, 5, 7, 7, 8, 9, 6, 0, 9, 1, 7, 3, 6, 3,
-	7, 1, 7, 8, 7, 2, 1, 4, 6, 8, 4, 4, 0, 9, 0, 1, 2, 2, 4, 9, 5, 3, 4, 3, 0, 1,
-	4, 6, 5, 4, 9, 5, 8, 5, 3, 7, 1, 0, 5, 0, 7, 9, 2, 2, 7, 9, 6, 8, 9, 2, 5, 8,
-	9, 2, 3, 5, 4, 2, 0, 1, 9, 9, 5, 6, 1, 1, 2, 1, 2, 9, 0, 2, 1, 9, 6, 0, 8, 6,
-	4, 0, 3, 4, 4, 1, 8, 1, 5, 9, 8, 1, 3, 6, 2, 9, 7, 7, 4, 7, 7, 1, 3, 0, 9, 9,
-	6, 0, 5, 1, 8, 7, 0, 7, 2, 1, 1, 3, 4, 9, 9, 9, 9, 9, 9, 8, 3, 7, 2, 9, 7, 8,
-	0, 4, 9, 9, 5, 1, 0, 5, 9, 7, 3, 1, 7, 3, 2, 8, 1, 6, 0, 9, 6, 3, 1, 8, 5, 9,
-	5, 0, 2, 4, 4, 5, 9, 4, 5, 5, 3, 4, 6, 9, 0, 8, 3, 0, 2, 6, 4, 2, 5, 2, 2, 3,
-	0, 8, 2, 5, 3, 3, 4, 4, 6, 8, 5, 0, 3, 5, 2, 6, 1, 9, 3, 1, 1, 8, 8, 1, 7, 1,
-	0, 1, 0, 0, 0, 3, 1, 3, 7, 8, 3, 8, 7, 5, 2, 8, 8, 6, 5, 8, 7, 5, 3, 3, 2, 0,
-	8, 3, 8, 1, 4, 2, 0, 6, 1, 7, 1, 7, 7, 6, 6, 9, 1, 4, 7, 3, 0, 3, 5, 9, 8, 2,
-	5, 3, 4, 9, 0, 4, 2, 8, 7, 5, 5, 4, 6, 8, 7, 3, 1, 1, 5, 9, 5, 6, 2, 8, 6, 3,
-	8, 8, 2, 3, 5, 3, 7, 8, 7, 5, 9, 3, 7, 5, 1, 9, 5, 7, 7, 8, 1, 8, 5, 7, 7, 8,
-	0, 5, 3, 2, 1, 7, 1, 2, 2, 6, 8, 0, 6, 6, 1, 3, 0, 0, 1, 9, 2, 7, 8, 7, 6, 6,
-	1, 1, 1, 9, 5, 9, 0, 9, 2, 1, 6, 4, 2, 0, 1}
+// Return a bound on the number of correct digits in z, the sine or cosine of x
+// computed with working precision w. Argument reduction leaves an absolute
+// error below the working precision, so results near zero lose relative
+// accuracy.
+func (x *Real) trigCorrectDigits(w uint, z *Real) int {
+	return int(w) - internalPrecisionBuffer + min(z.exponent, 0)
+}
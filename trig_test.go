@@ -4,7 +4,10 @@
 
 package number
 
-import "testing"
+import (
+	"fmt"
+	"testing"
+)
 
 func TestSine1(t *testing.T) {
 	x := NewUint64(5)
@@ -44,12 +47,14 @@ func TestSine4(t *testing.T) {
 	}
 }
 
-// sin(2π) == 0, but even the intel decimal arithmetic library gives us 2.316e-34.
+// sin(2π) == 0, but 2π rounded to 34 digits is slightly larger than 2π. The
+// intel decimal arithmetic library gives us 2.316e-34, but the correctly
+// rounded result needs far more working precision to overcome cancellation.
 func TestSine5(t *testing.T) {
 	x, _ := ParseReal("6.28318530717958647692528676655900576839433879875021164194988918461563281257", DefaultPrecision) // 2π
 	z := x.Sin()
 
-	if z.String() != "2.316e-34" {
+	if z.String() != "2.316056612012497883580501108153844e-34" {
 		t.Fatal("invalid sin", z.String())
 	}
 }
@@ -78,7 +83,7 @@ func TestCosine3(t *testing.T) {
 	x.SetPrecision(50)
 	z := x.Cos()
 
-	if z.String() != "5.232147853951389454975944733847094e-1" {
+	if z.String() != "5.232147853951389454975944733847095e-1" {
 		t.Fatal("invalid cos", z.String())
 	}
 }
@@ -152,12 +157,107 @@ func TestTangent4(t *testing.T) {
 	}
 }
 
-// tan(2π) == 0, but even the intel decimal arithmetic library gives us 2.316e-34.
+// tan(2π) == 0, but 2π rounded to 34 digits is slightly larger than 2π. See
+// TestSine5.
 func TestTangent5(t *testing.T) {
 	x, _ := ParseReal("6.28318530717958647692528676655900576839433879875021164194988918461563281257", DefaultPrecision) // 2π
 	z := x.Tan()
 
-	if z.String() != "2.316e-34" {
+	if z.String() != "2.316056612012497883580501108153844e-34" {
 		t.Fatal("invalid tan", z.String())
 	}
 }
+
+func TestSinCos(t *testing.T) {
+	x := NewFloat64(-7.5)
+	s, c := x.SinCos()
+
+	if s.String() != x.Sin().String() || s.String() != "-9.379999767747388579484637981490472e-1" {
+		t.Fatal("invalid sin", s)
+	}
+	if c.String() != x.Cos().String() || c.String() != "3.466353178350258109716193361718956e-1" {
+		t.Fatal("invalid cos", c)
+	}
+
+	s, c = NewInt64(0).SinCos()
+	if s.String() != "0" || c.String() != "1e0" {
+		t.Fatal("invalid sincos", s, c)
+	}
+}
+
+func TestSecant(t *testing.T) {
+	z := NewUint64(1).Sec()
+
+	if z.String() != "1.85081571768092561791175324139865e0" {
+		t.Fatal("invalid sec", z)
+	}
+}
+
+func TestCosecant(t *testing.T) {
+	z := NewUint64(1).Csc()
+
+	if z.String() != "1.188395105778121216261599452374551e0" {
+		t.Fatal("invalid csc", z)
+	}
+
+	z = NewUint64(0).Csc()
+	if !z.IsInf() {
+		t.Fatal("invalid csc", z)
+	}
+}
+
+func TestCotangent(t *testing.T) {
+	z := NewUint64(1).Cot()
+
+	if z.String() != "6.420926159343307030064199865942656e-1" {
+		t.Fatal("invalid cot", z)
+	}
+}
+
+func TestTrigNegative(t *testing.T) {
+	tests := []struct {
+		x, sin, cos string
+	}{
+		{"-123456.789", "9.986640823434470978675991225831434e-1", "5.167253271439977004278587443845058e-2"},
+		{"-1e22", "8.522008497671888017727058937530294e-1", "5.232147853951389454975944733847095e-1"},
+	}
+
+	for _, v := range tests {
+		x, err := ParseReal(v.x, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := x.Sin(); z.String() != v.sin {
+			t.Fatal("invalid sin", v.x, z)
+		}
+		if z := x.Cos(); z.String() != v.cos {
+			t.Fatal("invalid cos", v.x, z)
+		}
+	}
+}
+
+func TestTrigHuge(t *testing.T) {
+	// Reduction needs more digits of π than the stored table holds.
+	x, err := ParseReal("-1e1200", DefaultPrecision)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, c := x.SinCos()
+
+	if s.String() != "9.755155644189246773984246094153331e-1" {
+		t.Fatal("invalid sin", s)
+	}
+	if c.String() != "2.199304062116623698862645775095764e-1" {
+		t.Fatal("invalid cos", c)
+	}
+}
+
+func TestPiDigits(t *testing.T) {
+	x := NewInt64(1)
+	x.SetPrecision(1100)
+	s := fmt.Sprintf("%.1100e", pi(x))
+
+	if s[:12] != "3.1415926535" || s[len(s)-11:] != "834791315e0" {
+		t.Fatal("invalid pi", s)
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+// Return the sine of x, where x is in degrees. The angle is reduced modulo 360
+// exactly, so the result is exact at multiples of 30° and correctly rounded
+// otherwise.
+func (x *Real) SinDeg() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		s, _ := x.working(w).sincosDeg()
+		return s, int(w) - internalPrecisionBuffer
+	})
+}
+
+// Return the cosine of x, where x is in degrees. The angle is reduced modulo
+// 360 exactly, so the result is exact at multiples of 60° and correctly
+// rounded otherwise.
+func (x *Real) CosDeg() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		_, c := x.working(w).sincosDeg()
+		return c, int(w) - internalPrecisionBuffer
+	})
+}
+
+// Return the tangent of x, where x is in degrees. The angle is reduced modulo
+// 360 exactly, so the result is exact at multiples of 45° and correctly
+// rounded otherwise. Odd multiples of 90° return +Inf.
+func (x *Real) TanDeg() *Real {
+	return x.correctlyRounded(func(w uint) (*Real, int) {
+		s, c := x.working(w).sincosDeg()
+		return s.div(c), int(w) - internalPrecisionBuffer - 1
+	})
+}
+
+// Return the sine and cosine of x, where x is in degrees. The angle is reduced
+// to [0, 45] exactly, and the special angles 0, 30, and 45 are handled
+// directly, so that symmetric angles give identical results.
+func (x *Real) sincosDeg() (s, c *Real) {
+	s = initFrom(x)
+	c = initFrom(x)
+	if x.IsInf() || x.IsNaN() {
+		s.form = FormNaN
+		c.form = FormNaN
+		return s, c
+	}
+
+	// Reduce x to q*90 + b, where q is the quadrant and b is in [0, 90).
+	r, frac := x.mod360()
+	q := r / 90
+	b := initFrom(x)
+	b.SetInt64(int64(r % 90))
+	b.precision = umax(x.precision, uint(frac.ndigits())+3)
+	b = b.Add(frac)
+
+	// Use the complement for angles above 45.
+	var swap bool
+	ninety := initFrom(b)
+	ninety.SetInt64(90)
+	if b.Compare(NewInt64(45)) == 1 {
+		b = ninety.Sub(b)
+		swap = true
+	}
+	b.precision = x.precision
+
+	switch {
+	case b.IsZero():
+		c.SetInt64(1)
+	case b.Compare(NewInt64(30)) == 0:
+		// sin 30° = 1/2, cos 30° = √3/2
+		s.SetInt64(5)
+		s.exponent = -1
+		three := initFrom(x)
+		three.SetInt64(3)
+		c = three.sqrt().mul(s)
+	case b.Compare(NewInt64(45)) == 0:
+		// sin 45° = cos 45° = √2/2
+		half := initFrom(x)
+		half.SetInt64(5)
+		half.exponent = -1
+		two := initFrom(x)
+		two.SetInt64(2)
+		s = two.sqrt().mul(half)
+		c = s.Copy()
+	default:
+		d := initFrom(x)
+		d.SetInt64(180)
+		s, c = b.mul(pi(x)).div(d).sincos()
+	}
+
+	if swap {
+		s, c = c, s
+	}
+
+	return rotate(q, s, c)
+}
+
+// Return x modulo 360, computed exactly, as its integer part r in [0, 360) and
+// its non-negative fractional part.
+func (x *Real) mod360() (r int, frac *Real) {
+	frac = initFrom(x)
+	if x.IsZero() {
+		return 0, frac
+	}
+
+	// Split the significand at the decimal point. Digits before the point
+	// are reduced one at a time. Any remaining implied zeros multiply the
+	// integer part by a power of ten, and 10^k ≡ 280 (mod 360) for k ≥ 3.
+	d := x.significandDigits()
+	n := min(max(x.exponent+1, 0), len(d))
+	for _, v := range d[:n] {
+		r = (r*10 + int(v)) % 360
+	}
+	if k := x.exponent + 1 - len(d); k > 0 {
+		switch k {
+		case 1:
+			r = r * 10 % 360
+		case 2:
+			r = r * 100 % 360
+		default:
+			r = r * 280 % 360
+		}
+	}
+
+	if n < len(d) {
+		frac.exponent = x.exponent - n
+		frac.setSignificandDigits(d[n:])
+		frac.precision = umax(x.precision, uint(len(d)-n))
+	}
+
+	// For negative values, x mod 360 = 360 - (|x| mod 360).
+	if x.negative {
+		if frac.IsZero() {
+			r = (360 - r) % 360
+		} else {
+			r = 359 - r
+			one := initFrom(frac)
+			one.SetInt64(1)
+			frac = one.Sub(frac)
+		}
+	}
+	return r, frac
+}
@@ -0,0 +1,51 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import "testing"
+
+func TestTrigDegrees(t *testing.T) {
+	tests := []struct {
+		x             string
+		sin, cos, tan string
+	}{
+		{"0", "0", "1e0", "0"},
+		{"30", "5e-1", "8.660254037844386467637231707529362e-1", "5.773502691896257645091487805019575e-1"},
+		{"45", "7.07106781186547524400844362104849e-1", "7.07106781186547524400844362104849e-1", "1e0"},
+		{"90", "1e0", "0", "∞"},
+		{"150", "5e-1", "-8.660254037844386467637231707529362e-1", "-5.773502691896257645091487805019575e-1"},
+		{"225", "-7.07106781186547524400844362104849e-1", "-7.07106781186547524400844362104849e-1", "1e0"},
+		{"300", "-8.660254037844386467637231707529362e-1", "5e-1", "-1.732050807568877293527446341505872e0"},
+		{"-30", "-5e-1", "8.660254037844386467637231707529362e-1", "-5.773502691896257645091487805019575e-1"},
+		{"720.5", "8.726535498373934964888213973584423e-3", "9.999619230641712887373551648269833e-1", "8.72686779075878933453619806120191e-3"},
+		{"1e40", "-9.84807753012208059366743024589523e-1", "1.736481776669303488517166267693148e-1", "-5.671281819617709530994418439863964e0"},
+		{"1e-50", "1.745329251994329576923690768488613e-52", "1e0", "1.745329251994329576923690768488613e-52"},
+		{"123456789.123", "-1.58554427807007452917441335371516e-1", "-9.873502384781159619045107879114812e-1", "1.605857998792813566323903161702917e-1"},
+	}
+
+	for _, v := range tests {
+		x, err := ParseReal(v.x, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := x.SinDeg(); z.String() != v.sin {
+			t.Fatal("invalid sin", v.x, z)
+		}
+		if z := x.CosDeg(); z.String() != v.cos {
+			t.Fatal("invalid cos", v.x, z)
+		}
+		if z := x.TanDeg(); z.String() != v.tan {
+			t.Fatal("invalid tan", v.x, z)
+		}
+	}
+}
+
+func TestTrigDegreesNaN(t *testing.T) {
+	x := NewInt64(1)
+	x.form = FormInf
+	if !x.SinDeg().IsNaN() || !x.CosDeg().IsNaN() {
+		t.Fatal("expected NaN")
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2025 David Fritz. All rights reserved.
+// This software may be modified and distributed under the terms of the BSD
+// 2-clause license. See the LICENSE file for details.
+
+package number
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestZeta(t *testing.T) {
+	tests := []struct {
+		x, want string
+	}{
+		{"0", "-5e-1"},
+		{"1", "∞"},
+		{"2", "1.644934066848226436472415166646025e0"},
+		{"3", "1.20205690315959428539973816151145e0"},
+		{"4", "1.082323233711138191516003696541168e0"},
+		{"0.5", "-1.460354508809586812889499152515298e0"},
+		{"1.000001", "1.000000577215737717373499101298209e6"},
+		{"0.999", "-9.994228571557887900099207604196947e2"},
+		{"1e-10", "-5.000000000918938533304990564585767e-1"},
+		{"50.5", "1.000000000000000628036984277733691e0"},
+		{"100", "1.000000000000000000000000000000789e0"},
+		{"1e30", "1e0"},
+		{"-1", "-8.333333333333333333333333333333333e-2"},
+		{"-2", "0"},
+		{"-3", "8.333333333333333333333333333333333e-3"},
+		{"-300", "0"},
+		{"-301", "-1.640889329580867897796304541890004e376"},
+		{"-0.5", "-2.078862249773545660173067253970493e-1"},
+		{"-2.5", "8.516928777850330542358567028344487e-3"},
+		{"-260.5", "-1.651249363253270800073020213847962e309"},
+	}
+
+	for _, v := range tests {
+		x, err := ParseReal(v.x, DefaultPrecision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z := x.Zeta(); z.String() != v.want {
+			t.Fatal("invalid zeta", v.x, z)
+		}
+	}
+}
+
+func TestZetaHighPrecision(t *testing.T) {
+	x, err := ParseReal("3", 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	z := x.Zeta()
+
+	if fmt.Sprintf("%.50e", z) != "1.2020569031595942853997381615114499907649862923405e0" {
+		t.Fatal("invalid zeta", fmt.Sprintf("%.50e", z))
+	}
+}